@@ -0,0 +1,131 @@
+package forth
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+
+	"goforth/variant"
+)
+
+func hostValue(value any) (variant.Variant, error) {
+	if value == nil {
+		return variant.ForthNil{}, nil
+	} else if variantValue, isVariant := value.(variant.Variant); isVariant {
+		return variantValue, nil
+	}
+
+	var reflected = reflect.ValueOf(value)
+	if !isHostType(reflected.Type()) {
+		return nil, fmt.Errorf("can't convert %v (%T) to a Forth value", value, value)
+	}
+
+	return toVariant(reflected), nil
+}
+
+func (program *ForthProgram) Push(values ...any) error {
+	for _, value := range values {
+		var converted, err = hostValue(value)
+		if err != nil {
+			return err
+		}
+
+		if !program.forthStack.Push(converted) {
+			return variant.NewError(variant.ErrStackOverflow, "Stack overflow pushing %v", converted)
+		}
+	}
+
+	return nil
+}
+
+func (program *ForthProgram) Pop() (variant.Variant, error) {
+	if value, popped := program.forthStack.Pop(); popped {
+		return value, nil
+	}
+
+	return nil, variant.NewError(variant.ErrStackUnderflow, "Attempted to pop, but the stack is empty")
+}
+
+func (program *ForthProgram) Depth() int {
+	return program.forthStack.Size()
+}
+
+// Snapshot copies the data stack bottom-first, the order '.s' prints it in.
+func (program *ForthProgram) Snapshot() []variant.Variant {
+	var elements = program.forthStack.Array()
+	for i, j := 0, len(elements)-1; i < j; i, j = i+1, j-1 {
+		elements[i], elements[j] = elements[j], elements[i]
+	}
+
+	return elements
+}
+
+// Call runs a single word with args pushed bottom-first and returns whatever
+// it leaves above the original stack depth. A failed call leaves the stack as
+// it was before the arguments were pushed, including errors CATCH can't
+// intercept such as limits, interrupts and BYE.
+func (program *ForthProgram) Call(word string, args ...any) (results []variant.Variant, err error) {
+	if !isWordDefined(program, word) {
+		return nil, variant.NewError(variant.ErrUndefinedWord, "Unrecognized word '%s'", word)
+	}
+
+	defer program.beginExecution()()
+	var state = saveExecutionState(program)
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			var forthError, isForthError = recovered.(*variant.ForthError)
+			if !isForthError {
+				panic(recovered)
+			}
+
+			state.restore(program)
+			results, err = nil, forthError
+		}
+	}()
+
+	var depth = program.forthStack.Size()
+	if err := program.Push(args...); err != nil {
+		state.restore(program)
+		return nil, err
+	}
+
+	ExecuteWord(program, word)
+	results = make([]variant.Variant, max(program.forthStack.Size()-depth, 0))
+	for i := len(results) - 1; i >= 0; i-- {
+		results[i], _ = program.forthStack.Pop()
+	}
+
+	return results, nil
+}
+
+// Words lists every name the program can currently execute, sorted, leaving
+// out builtins its capabilities don't permit.
+func (program *ForthProgram) Words() []string {
+	var names = []string{":", ";", "true", "false", "nil"}
+	for name := range binaryOperators {
+		names = append(names, name)
+	}
+
+	for name := range unaryOperators {
+		names = append(names, name)
+	}
+
+	for name := range builtinFunctions {
+		if capability, restricted := wordCapabilities[name]; !restricted || program.capabilities&capability == capability {
+			names = append(names, name)
+		}
+	}
+
+	if program.capabilities&CapabilityHost == CapabilityHost {
+		for name := range program.hostWords {
+			names = append(names, name)
+		}
+	}
+
+	for name := range program.definedWords {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+	return slices.Compact(names)
+}
@@ -0,0 +1,32 @@
+package forth
+
+import (
+	"maps"
+
+	"goforth/variant"
+)
+
+// WithArgs gives scripts the command-line arguments that followed them,
+// available through the ARGS word.
+func WithArgs(args []string) ProgramOption {
+	return func(program *ForthProgram) {
+		program.args = args
+	}
+}
+
+func arguments(program *ForthProgram) {
+	var list = make(variant.ForthList, len(program.args))
+	for i, arg := range program.args {
+		list[i] = variant.ForthString(arg)
+	}
+
+	pushValue(program, list)
+}
+
+var argsFunctions = map[string]func(*ForthProgram){
+	"args": arguments,
+}
+
+func init() {
+	maps.Copy(builtinFunctions, argsFunctions)
+}
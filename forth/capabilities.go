@@ -0,0 +1,93 @@
+package forth
+
+import (
+	"strings"
+
+	"goforth/variant"
+)
+
+type Capability uint
+
+const (
+	CapabilityIO Capability = 1 << iota
+	CapabilityFS
+	CapabilityRand
+	CapabilityTime
+	CapabilityHost
+
+	AllCapabilities = CapabilityIO | CapabilityFS | CapabilityRand | CapabilityTime | CapabilityHost
+	NoCapabilities  = Capability(0)
+)
+
+var capabilityNames = [...]string{"io", "fs", "rand", "time", "host"}
+
+var wordCapabilities = map[string]Capability{
+	".":    CapabilityIO,
+	",":    CapabilityIO,
+	"emit": CapabilityIO,
+	".s":   CapabilityIO,
+	"f.":   CapabilityIO,
+
+	"rand":  CapabilityRand,
+	"randf": CapabilityRand,
+}
+
+func requireCapability(functions map[string]func(*ForthProgram), capability Capability) {
+	for name := range functions {
+		wordCapabilities[name] = capability
+	}
+}
+
+func (capability Capability) String() string {
+	var names []string
+	for i, name := range capabilityNames {
+		if capability&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+
+	return strings.Join(names, ",")
+}
+
+func ParseCapabilities(text string) (Capability, bool) {
+	var capabilities = NoCapabilities
+	for _, name := range strings.Split(text, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		} else if name == "all" {
+			capabilities |= AllCapabilities
+			continue
+		}
+
+		var found = false
+		for i, capabilityName := range capabilityNames {
+			if name == capabilityName {
+				capabilities |= 1 << i
+				found = true
+			}
+		}
+
+		if !found {
+			return NoCapabilities, false
+		}
+	}
+
+	return capabilities, true
+}
+
+func WithCapabilities(capabilities Capability) ProgramOption {
+	return func(program *ForthProgram) {
+		program.capabilities = capabilities
+	}
+}
+
+func (program *ForthProgram) Capabilities() Capability {
+	return program.capabilities
+}
+
+func checkPermitted(program *ForthProgram, word string, capability Capability) {
+	if program.capabilities&capability != capability {
+		variant.Raise(variant.ErrWordNotPermitted, "Word '%s' not permitted; it needs the '%v' capability", word, capability)
+	}
+}
@@ -0,0 +1,61 @@
+package forth
+
+import (
+	"maps"
+	"unicode/utf8"
+
+	"goforth/variant"
+)
+
+func popChar(program *ForthProgram, word string) variant.ForthChar {
+	requireDepth(program, 1, word)
+	var top, _ = program.forthStack.Pop()
+	if char, isChar := top.(variant.ForthChar); isChar {
+		return char
+	}
+
+	variant.Raise(variant.ErrTypeMismatch, "'%s' expects a character (got %v)", word, top)
+	return 0
+}
+
+func charLiteral(program *ForthProgram) {
+	var name = nextWord(program, "char")
+	var char, _ = utf8.DecodeRuneInString(name)
+	pushValue(program, variant.ForthChar(char))
+}
+
+func pushBlank(program *ForthProgram) {
+	pushValue(program, variant.ForthChar(' '))
+}
+
+func toChar(program *ForthProgram) {
+	requireDepth(program, 1, ">char")
+	var top, _ = program.forthStack.Pop()
+	if char, isChar := variant.ToChar(top); isChar {
+		pushValue(program, char)
+		return
+	}
+
+	variant.Raise(variant.ErrTypeMismatch, "'>char' expects a code point or a single-character string (got %v)", top)
+}
+
+func charToInt(program *ForthProgram) {
+	pushValue(program, variant.ForthInt(popChar(program, "char>int")))
+}
+
+func charToString(program *ForthProgram) {
+	pushValue(program, variant.ForthString(popChar(program, "char>string").String()))
+}
+
+var charFunctions = map[string]func(*ForthProgram){
+	"char":        charLiteral,
+	"[char]":      charLiteral,
+	"bl":          pushBlank,
+	">char":       toChar,
+	"char>int":    charToInt,
+	"char>string": charToString,
+}
+
+func init() {
+	maps.Copy(builtinFunctions, charFunctions)
+}
@@ -0,0 +1,58 @@
+package forth
+
+import (
+	"maps"
+	"math/cmplx"
+
+	"goforth/variant"
+)
+
+func popComplex(program *ForthProgram, word string) complex128 {
+	requireDepth(program, 1, word)
+	var top, _ = program.forthStack.Pop()
+	if value, isNumber := variant.ToComplex(top); isNumber {
+		return complex128(value)
+	}
+
+	variant.Raise(variant.ErrTypeMismatch, "Invalid '%s' operand (%v)", word, top)
+	return 0
+}
+
+func complexUnary(word string, operation func(complex128) complex128) func(*ForthProgram) {
+	return func(program *ForthProgram) {
+		pushValue(program, variant.ForthComplex(operation(popComplex(program, word))))
+	}
+}
+
+func complexToFloat(word string, operation func(complex128) float64) func(*ForthProgram) {
+	return func(program *ForthProgram) {
+		pushFloat(program, variant.ForthFloat(operation(popComplex(program, word))))
+	}
+}
+
+func makeComplex(program *ForthProgram) {
+	var imaginary = popFloat(program, "complex")
+	var realPart = popFloat(program, "complex")
+	pushValue(program, variant.ForthComplex(complex(float64(realPart), float64(imaginary))))
+}
+
+func fromPolar(program *ForthProgram) {
+	var angle = popFloat(program, "polar")
+	var magnitude = popFloat(program, "polar")
+	pushValue(program, variant.ForthComplex(cmplx.Rect(float64(magnitude), float64(angle))))
+}
+
+var complexFunctions = map[string]func(*ForthProgram){
+	"complex": makeComplex,
+	"polar":   fromPolar,
+	"real":    complexToFloat("real", func(value complex128) float64 { return real(value) }),
+	"imag":    complexToFloat("imag", func(value complex128) float64 { return imag(value) }),
+	"carg":    complexToFloat("carg", cmplx.Phase),
+	"conj":    complexUnary("conj", cmplx.Conj),
+	"cexp":    complexUnary("cexp", cmplx.Exp),
+	"csqrt":   complexUnary("csqrt", cmplx.Sqrt),
+}
+
+func init() {
+	maps.Copy(builtinFunctions, complexFunctions)
+}
@@ -0,0 +1,120 @@
+package forth
+
+import (
+	"errors"
+	"maps"
+	"strings"
+
+	"goforth/variant"
+)
+
+func typeOf(program *ForthProgram) {
+	requireDepth(program, 1, "typeof")
+	var top, _ = program.forthStack.Pop()
+	pushValue(program, variant.ForthString(top.TypeName()))
+}
+
+// raiseConversionError keeps the code of a ForthError from a conversion
+// method, and treats any other error, say from a registered type, as a type
+// mismatch.
+func raiseConversionError(word string, value variant.Variant, err error) {
+	var forthError *variant.ForthError
+	if errors.As(err, &forthError) {
+		variant.Raise(forthError.Code, "%s", forthError.Message)
+	}
+
+	variant.Raise(variant.ErrTypeMismatch, "Invalid '%s' operand (%v): %v", word, value, err)
+}
+
+func toInt(program *ForthProgram) {
+	requireDepth(program, 1, ">int")
+	var top, _ = program.forthStack.Pop()
+	var converted, err = top.ToInt()
+	if err != nil {
+		raiseConversionError(">int", top, err)
+	}
+
+	pushValue(program, converted)
+}
+
+func toFloat(program *ForthProgram) {
+	requireDepth(program, 1, ">float")
+	var top, _ = program.forthStack.Pop()
+	var converted, err = top.ToFloat()
+	if err != nil {
+		raiseConversionError(">float", top, err)
+	}
+
+	pushFloat(program, converted)
+}
+
+func toString(program *ForthProgram) {
+	requireDepth(program, 1, ">string")
+	var top, _ = program.forthStack.Pop()
+	pushValue(program, top.ToString())
+}
+
+// toBool parses a string, which must read "true" or "false" in any case,
+// and takes the truth of any other value as IF would.
+func toBool(program *ForthProgram) {
+	requireDepth(program, 1, ">bool")
+	var top, _ = program.forthStack.Pop()
+	if str, isString := top.(variant.ForthString); isString {
+		switch strings.ToLower(strings.TrimSpace(string(str))) {
+		case "true":
+			pushValue(program, variant.ForthBool(true))
+		case "false":
+			pushValue(program, variant.ForthBool(false))
+		default:
+			variant.Raise(variant.ErrTypeMismatch, "%q is not a boolean", string(str))
+		}
+
+		return
+	}
+
+	pushValue(program, variant.ForthBool(top.AsBool()))
+}
+
+func typePredicate(word string, matches func(variant.Variant) bool) func(*ForthProgram) {
+	return func(program *ForthProgram) {
+		requireDepth(program, 1, word)
+		var top, _ = program.forthStack.Pop()
+		pushValue(program, variant.ForthBool(matches(top)))
+	}
+}
+
+func isType[T variant.Variant](value variant.Variant) bool {
+	var _, matches = value.(T)
+	return matches
+}
+
+func isInteger(value variant.Variant) bool {
+	return isType[variant.ForthInt](value) || isType[variant.ForthBigInt](value)
+}
+
+func isNumber(value variant.Variant) bool {
+	var _, matches = variant.ToComplex(value)
+	return matches
+}
+
+var convertFunctions = map[string]func(*ForthProgram){
+	"typeof":  typeOf,
+	">int":    toInt,
+	">float":  toFloat,
+	">string": toString,
+	">bool":   toBool,
+
+	"int?":    typePredicate("int?", isInteger),
+	"float?":  typePredicate("float?", isType[variant.ForthFloat]),
+	"number?": typePredicate("number?", isNumber),
+	"string?": typePredicate("string?", isType[variant.ForthString]),
+	"bool?":   typePredicate("bool?", isType[variant.ForthBool]),
+	"char?":   typePredicate("char?", isType[variant.ForthChar]),
+	"list?":   typePredicate("list?", isType[variant.ForthList]),
+	"map?":    typePredicate("map?", isType[variant.ForthMap]),
+	"xt?":     typePredicate("xt?", isType[variant.ForthXt]),
+}
+
+func init() {
+	maps.Copy(builtinFunctions, convertFunctions)
+}
@@ -0,0 +1,164 @@
+package forth
+
+import (
+	"maps"
+	"strings"
+
+	"goforth/variant"
+)
+
+func nextWord(program *ForthProgram, word string) string {
+	if program.wordIndex+1 >= len(program.currentWords) {
+		variant.Raise(variant.ErrZeroLengthName, "'%s' expects a word name to follow it", word)
+	}
+
+	program.wordIndex++
+	return program.currentWords[program.wordIndex]
+}
+
+func isWordDefined(program *ForthProgram, word string) bool {
+	var wordLower = strings.ToLower(word)
+	if _, found := binaryOperators[wordLower]; found {
+		return true
+	} else if _, found := unaryOperators[wordLower]; found {
+		return true
+	} else if _, found := builtinFunctions[wordLower]; found {
+		return true
+	} else if _, found := program.hostWords[wordLower]; found {
+		return true
+	}
+
+	var _, found = program.definedWords[word]
+	return found
+}
+
+func popXt(program *ForthProgram, word string) variant.ForthXt {
+	requireDepth(program, 1, word)
+	var top, _ = program.forthStack.Pop()
+	if xt, isXt := top.(variant.ForthXt); isXt {
+		return xt
+	}
+
+	variant.Raise(variant.ErrTypeMismatch, "'%s' expects an execution token (got %v)", word, top)
+	return ""
+}
+
+func tick(program *ForthProgram) {
+	var name = nextWord(program, "'")
+	if !isWordDefined(program, name) {
+		variant.Raise(variant.ErrUndefinedWord, "Unrecognized word '%s'", name)
+	}
+
+	pushValue(program, variant.ForthXt(name))
+}
+
+func execute(program *ForthProgram) {
+	ExecuteWord(program, string(popXt(program, "execute")))
+}
+
+type executionState struct {
+	stack       []variant.Variant
+	floatDepth  int
+	loopDepth   int
+	branchDepth int
+	listDepth   int
+	words       []string
+	wordIndex   int
+}
+
+func saveExecutionState(program *ForthProgram) executionState {
+	return executionState{
+		stack:       program.forthStack.Array(),
+		floatDepth:  program.floatStack.Size(),
+		loopDepth:   program.loopStack.Size(),
+		branchDepth: program.branchStack.Size(),
+		listDepth:   program.listStarts.Size(),
+		words:       program.currentWords,
+		wordIndex:   program.wordIndex,
+	}
+}
+
+func (state *executionState) restore(program *ForthProgram) {
+	program.forthStack.Clear()
+	for i := len(state.stack) - 1; i >= 0; i-- {
+		program.forthStack.Push(state.stack[i])
+	}
+
+	for program.floatStack.Size() > state.floatDepth {
+		program.floatStack.Pop()
+	}
+
+	for program.loopStack.Size() > state.loopDepth {
+		program.loopStack.Pop()
+	}
+
+	for program.branchStack.Size() > state.branchDepth {
+		program.branchStack.Pop()
+	}
+
+	for program.listStarts.Size() > state.listDepth {
+		program.listStarts.Pop()
+	}
+
+	program.currentWords, program.wordIndex = state.words, state.wordIndex
+}
+
+func executeCaught(program *ForthProgram, word string) (caught *variant.ForthError) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			var forthError, isForthError = recovered.(*variant.ForthError)
+			if !isForthError || !isCatchable(forthError.Code) {
+				panic(recovered)
+			}
+
+			caught = forthError
+		}
+	}()
+
+	ExecuteWord(program, word)
+	return nil
+}
+
+func isCatchable(code variant.ErrorCode) bool {
+	switch code {
+	case variant.ErrUserInterrupt, variant.ErrStepLimitExceeded, variant.ErrMemoryLimitExceeded, variant.ErrOutputLimitExceeded, variant.ErrBye:
+		return false
+	default:
+		return true
+	}
+}
+
+func catch(program *ForthProgram) {
+	var xt = popXt(program, "catch")
+	var state = saveExecutionState(program)
+	if caught := executeCaught(program, string(xt)); caught != nil {
+		state.restore(program)
+		pushValue(program, variant.ForthInt(caught.Code))
+	} else {
+		pushValue(program, variant.ForthInt(0))
+	}
+}
+
+func throw(program *ForthProgram) {
+	var code = popInt(program, "throw")
+	if code != 0 {
+		variant.Raise(variant.ErrorCode(code), "Uncaught exception %d", code)
+	}
+}
+
+func abort(program *ForthProgram) {
+	variant.Raise(variant.ErrAbort, "Aborted")
+}
+
+var exceptionFunctions = map[string]func(*ForthProgram){
+	"'":       tick,
+	"[']":     tick,
+	"execute": execute,
+	"catch":   catch,
+	"throw":   throw,
+	"abort":   abort,
+}
+
+func init() {
+	maps.Copy(builtinFunctions, exceptionFunctions)
+}
@@ -0,0 +1,250 @@
+package forth
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"goforth/variant"
+)
+
+func pushFloat(program *ForthProgram, value variant.ForthFloat) {
+	if program.separateFloatStack {
+		if !program.floatStack.Push(value) {
+			variant.Raise(variant.ErrStackOverflow, "Float stack depth limit of %d exceeded", program.floatStack.MaxDepth())
+		}
+	} else {
+		pushValue(program, value)
+	}
+}
+
+func popFloat(program *ForthProgram, word string) variant.ForthFloat {
+	if program.separateFloatStack {
+		if value, ok := program.floatStack.Pop(); ok {
+			return value
+		}
+
+		variant.Raise(variant.ErrFloatStackUnderflow, "Attempted to '%s', but the float stack is empty", word)
+	}
+
+	var top, ok = program.forthStack.Pop()
+	if !ok {
+		variant.Raise(variant.ErrStackUnderflow, "Attempted to '%s', but the stack is empty", word)
+	}
+
+	switch topCast := top.(type) {
+	case variant.ForthFloat:
+		return topCast
+	case variant.ForthInt:
+		return variant.ForthFloat(topCast)
+	case variant.ForthBigInt:
+		return topCast.Float()
+	case variant.ForthRational:
+		return topCast.Float()
+	case variant.ForthDecimal:
+		return topCast.Float()
+	default:
+		variant.Raise(variant.ErrTypeMismatch, "'%s' expects a floating-point operand (got %v)", word, top)
+		return 0
+	}
+}
+
+// parseFloatLiteral also accepts the ANS form with a bare exponent marker,
+// such as 1e or 1.5E, once the separate float stack is on.
+func parseFloatLiteral(program *ForthProgram, word string) (variant.ForthFloat, bool) {
+	var value, err = strconv.ParseFloat(word, 64)
+	if err != nil && program.separateFloatStack && strings.HasSuffix(strings.ToLower(word), "e") {
+		value, err = strconv.ParseFloat(word+"0", 64)
+	}
+
+	return variant.ForthFloat(value), err == nil
+}
+
+func floatDepth(program *ForthProgram) int {
+	if program.separateFloatStack {
+		return program.floatStack.Size()
+	}
+
+	return program.forthStack.Size()
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////
+
+func floatBinary(word string, operation func(variant.ForthFloat, variant.ForthFloat) variant.ForthFloat) func(*ForthProgram) {
+	return func(program *ForthProgram) {
+		var rhs = popFloat(program, word)
+		var lhs = popFloat(program, word)
+		pushFloat(program, operation(lhs, rhs))
+	}
+}
+
+func floatCompare(word string, comparison func(variant.ForthFloat, variant.ForthFloat) bool) func(*ForthProgram) {
+	return func(program *ForthProgram) {
+		var rhs = popFloat(program, word)
+		var lhs = popFloat(program, word)
+		pushValue(program, variant.ForthBool(comparison(lhs, rhs)))
+	}
+}
+
+func floatCompareZero(word string, comparison func(variant.ForthFloat) bool) func(*ForthProgram) {
+	return func(program *ForthProgram) {
+		var operand = popFloat(program, word)
+		pushValue(program, variant.ForthBool(comparison(operand)))
+	}
+}
+
+func floatNegate(program *ForthProgram) {
+	pushFloat(program, -popFloat(program, "fnegate"))
+}
+
+func floatPrint(program *ForthProgram) {
+	fmt.Fprint(program.output(), popFloat(program, "f.").ToString())
+}
+
+func floatDrop(program *ForthProgram) {
+	popFloat(program, "fdrop")
+}
+
+func floatDup(program *ForthProgram) {
+	var top = popFloat(program, "fdup")
+	pushFloat(program, top)
+	pushFloat(program, top)
+}
+
+func floatSwap(program *ForthProgram) {
+	var top = popFloat(program, "fswap")
+	var second = popFloat(program, "fswap")
+	pushFloat(program, top)
+	pushFloat(program, second)
+}
+
+func floatOver(program *ForthProgram) {
+	var top = popFloat(program, "fover")
+	var second = popFloat(program, "fover")
+	pushFloat(program, second)
+	pushFloat(program, top)
+	pushFloat(program, second)
+}
+
+func floatRotate(program *ForthProgram) {
+	var top = popFloat(program, "frot")
+	var second = popFloat(program, "frot")
+	var third = popFloat(program, "frot")
+	pushFloat(program, second)
+	pushFloat(program, top)
+	pushFloat(program, third)
+}
+
+func floatStackDepth(program *ForthProgram) {
+	pushValue(program, variant.ForthInt(floatDepth(program)))
+}
+
+func intToFloat(program *ForthProgram) {
+	var top, ok = program.forthStack.Pop()
+	if !ok {
+		variant.Raise(variant.ErrStackUnderflow, "Attempted to 's>f', but the stack is empty")
+	}
+
+	switch topCast := top.(type) {
+	case variant.ForthInt:
+		pushFloat(program, variant.ForthFloat(topCast))
+	case variant.ForthBigInt:
+		pushFloat(program, topCast.Float())
+	default:
+		variant.Raise(variant.ErrTypeMismatch, "'s>f' expects an integer operand (got %v)", top)
+	}
+}
+
+func floatToInt(program *ForthProgram) {
+	var operand = popFloat(program, "f>s")
+	if math.IsNaN(float64(operand)) || operand < math.MinInt64 || operand >= math.MaxInt64 {
+		variant.Raise(variant.ErrResultOutOfRange, "'f>s' operand is out of integer range (%v)", operand)
+	}
+
+	pushValue(program, variant.ForthInt(operand))
+}
+
+// doubleToFloat takes an ANS double-cell integer, the low cell below the
+// high one.
+func doubleToFloat(program *ForthProgram) {
+	var high = popInt(program, "d>f")
+	var low = popInt(program, "d>f")
+	var value = new(big.Int).Lsh(big.NewInt(int64(high)), 64)
+	value.Add(value, new(big.Int).SetUint64(uint64(low)))
+	var float, _ = new(big.Float).SetInt(value).Float64()
+	pushFloat(program, variant.ForthFloat(float))
+}
+
+func floatToDouble(program *ForthProgram) {
+	var operand = popFloat(program, "f>d")
+	if math.IsNaN(float64(operand)) || math.Abs(float64(operand)) >= math.Ldexp(1, 127) {
+		variant.Raise(variant.ErrResultOutOfRange, "'f>d' operand is out of double-cell range (%v)", operand)
+	}
+
+	var value, _ = big.NewFloat(math.Trunc(float64(operand))).Int(nil)
+	var low = new(big.Int).And(value, new(big.Int).SetUint64(math.MaxUint64))
+	pushValue(program, variant.ForthInt(low.Uint64()))
+	pushValue(program, variant.ForthInt(value.Rsh(value, 64).Int64()))
+}
+
+// floatVariable defines a word that pushes the address of a new float cell,
+// initialised to zero, for f@ and f! to use.
+func floatVariable(program *ForthProgram) {
+	var name = nextWord(program, "fvariable")
+	defineWord(program, name, []string{strconv.Itoa(len(program.floatCells))})
+	program.floatCells = append(program.floatCells, 0)
+}
+
+func popFloatAddress(program *ForthProgram, word string) int {
+	var address = popInt(program, word)
+	if address < 0 || int(address) >= len(program.floatCells) {
+		variant.Raise(variant.ErrInvalidMemoryAddress, "Invalid '%s' address (%v)", word, address)
+	}
+
+	return int(address)
+}
+
+func floatFetch(program *ForthProgram) {
+	pushFloat(program, program.floatCells[popFloatAddress(program, "f@")])
+}
+
+func floatStore(program *ForthProgram) {
+	var address = popFloatAddress(program, "f!")
+	program.floatCells[address] = popFloat(program, "f!")
+}
+
+var floatFunctions = map[string]func(*ForthProgram){
+	"f+": floatBinary("f+", func(lhs variant.ForthFloat, rhs variant.ForthFloat) variant.ForthFloat { return lhs + rhs }),
+	"f-": floatBinary("f-", func(lhs variant.ForthFloat, rhs variant.ForthFloat) variant.ForthFloat { return lhs - rhs }),
+	"f*": floatBinary("f*", func(lhs variant.ForthFloat, rhs variant.ForthFloat) variant.ForthFloat { return lhs * rhs }),
+	"f/": floatBinary("f/", func(lhs variant.ForthFloat, rhs variant.ForthFloat) variant.ForthFloat { return lhs / rhs }),
+
+	"f<":  floatCompare("f<", func(lhs variant.ForthFloat, rhs variant.ForthFloat) bool { return lhs < rhs }),
+	"f=":  floatCompare("f=", func(lhs variant.ForthFloat, rhs variant.ForthFloat) bool { return lhs == rhs }),
+	"f0<": floatCompareZero("f0<", func(operand variant.ForthFloat) bool { return operand < 0 }),
+	"f0=": floatCompareZero("f0=", func(operand variant.ForthFloat) bool { return operand == 0 }),
+
+	"fnegate": floatNegate,
+	"f.":      floatPrint,
+	"fdrop":   floatDrop,
+	"fdup":    floatDup,
+	"fswap":   floatSwap,
+	"fover":   floatOver,
+	"frot":    floatRotate,
+	"fdepth":  floatStackDepth,
+	"s>f":     intToFloat,
+	"d>f":     doubleToFloat,
+	"f>s":     floatToInt,
+	"f>d":     floatToDouble,
+
+	"fvariable": floatVariable,
+	"f@":        floatFetch,
+	"f!":        floatStore,
+}
+
+func init() {
+	maps.Copy(builtinFunctions, floatFunctions)
+}
@@ -1,9 +1,15 @@
 package forth
 
 import (
+	"bufio"
+	"context"
 	"fmt"
-	"log"
+	"io"
+	"maps"
+	"math/big"
 	"math/rand/v2"
+	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"unicode"
@@ -29,14 +35,56 @@ type ForthProgram struct {
 	forthStack   stack.Stack[variant.Variant]
 	definedWords map[string][]string
 
-	wordIndex   int
-	loopStack   stack.Stack[loopEntry]
-	branchStack stack.Stack[branchEntry]
-}
-
-func NewForthProgram() ForthProgram {
-	var program ForthProgram
+	floatStack         stack.Stack[variant.ForthFloat]
+	floatCells         []variant.ForthFloat
+	separateFloatStack bool
+
+	checkedArithmetic bool
+	promoteBigInts    bool
+
+	currentWords []string
+	wordIndex    int
+	compiling    bool
+	definition   []string
+	loopStack    stack.Stack[loopEntry]
+	branchStack  stack.Stack[branchEntry]
+	listStarts   stack.Stack[int]
+
+	includes     []includeFrame
+	included     map[string]bool
+	variantTypes []VariantType
+	hostWords    map[string]func(*ForthProgram)
+
+	stdout io.Writer
+	stderr io.Writer
+	stdin  *bufio.Reader
+	trace  io.Writer
+	args   []string
+
+	capabilities   Capability
+	randomSource   *rand.PCG
+	random         *rand.Rand
+	ctx            context.Context
+	limits         limits
+	usage          usage
+	executionDepth int
+}
+
+func NewForthProgram(options ...ProgramOption) *ForthProgram {
+	var program = &ForthProgram{}
 	program.definedWords = make(map[string][]string, 5)
+	program.hostWords = make(map[string]func(*ForthProgram))
+	program.included = make(map[string]bool)
+	program.capabilities = AllCapabilities
+	program.randomSource = newRandomSource()
+	program.random = rand.New(program.randomSource)
+	program.stdout = os.Stdout
+	program.stderr = os.Stderr
+	program.stdin = bufio.NewReader(os.Stdin)
+	for _, option := range options {
+		option(program)
+	}
+
 	return program
 }
 
@@ -48,11 +96,78 @@ func (program *ForthProgram) StackPop() {
 	program.forthStack.Pop()
 }
 
+func (program *ForthProgram) FloatStackTop() *variant.ForthFloat {
+	return program.floatStack.Top()
+}
+
+func (program *ForthProgram) FloatStackPop() {
+	program.floatStack.Pop()
+}
+
+func (program *ForthProgram) SetSeparateFloatStack(enabled bool) {
+	program.separateFloatStack = enabled
+}
+
+func (program *ForthProgram) SetCheckedArithmetic(enabled bool) {
+	program.checkedArithmetic = enabled
+}
+
+func (program *ForthProgram) SetBigIntPromotion(enabled bool) {
+	program.promoteBigInts = enabled
+}
+
 func (program *ForthProgram) Reset() {
 	program.forthStack.Clear()
+	program.floatStack.Clear()
 	program.loopStack.Clear()
+	program.branchStack.Clear()
+	program.listStarts.Clear()
 	program.definedWords = make(map[string][]string, 5)
+	program.floatCells = nil
 	program.wordIndex = 0
+	program.compiling, program.definition = false, nil
+	program.included = make(map[string]bool)
+}
+
+// IsCompiling reports whether a ':' definition has been opened on an earlier
+// line and is still waiting for its ';'.
+func (program *ForthProgram) IsCompiling() bool {
+	return program.compiling
+}
+
+// Clone copies the dictionary, host registrations and every stack so the
+// clone can run independently, then applies options to the clone. Unless
+// they replace them, both programs keep writing to the same stdout/stderr
+// and reading from the same buffered stdin, which isn't safe to read from
+// two goroutines at once: give clones that run concurrently and read input
+// their own WithStdin.
+func (program *ForthProgram) Clone(options ...ProgramOption) *ForthProgram {
+	var clone = *program
+	clone.forthStack = program.forthStack.Clone()
+	clone.floatStack = program.floatStack.Clone()
+	clone.floatCells = slices.Clone(program.floatCells)
+	clone.loopStack = program.loopStack.Clone()
+	clone.branchStack = program.branchStack.Clone()
+	clone.listStarts = program.listStarts.Clone()
+	clone.currentWords = slices.Clone(program.currentWords)
+	clone.definition = slices.Clone(program.definition)
+	clone.variantTypes = slices.Clone(program.variantTypes)
+	clone.hostWords = maps.Clone(program.hostWords)
+	clone.includes = slices.Clone(program.includes)
+	clone.included = maps.Clone(program.included)
+	var randomSource = *program.randomSource
+	clone.randomSource = &randomSource
+	clone.random = rand.New(clone.randomSource)
+	clone.definedWords = make(map[string][]string, len(program.definedWords))
+	for name, words := range program.definedWords {
+		clone.definedWords[name] = slices.Clone(words)
+	}
+
+	for _, option := range options {
+		option(&clone)
+	}
+
+	return &clone
 }
 
 ///////////////////////////////////////////////////////////////////////////////////////////////////
@@ -122,20 +237,20 @@ func ge(lhs variant.Variant, rhs variant.Variant) variant.Variant {
 func printTop(program *ForthProgram) {
 	if !program.forthStack.IsEmpty() {
 		var top = program.forthStack.Top()
-		fmt.Printf("%v", *top)
+		fmt.Fprint(program.output(), (*top).ToString())
 		program.forthStack.Pop()
 	} else {
-		log.Fatal("Error: Attempted to print, but the stack is empty")
+		variant.Raise(variant.ErrStackUnderflow, "Attempted to print, but the stack is empty")
 	}
 }
 
 func printTopLn(program *ForthProgram) {
 	if !program.forthStack.IsEmpty() {
 		var top = program.forthStack.Top()
-		fmt.Printf("%v\n", *top)
+		fmt.Fprintln(program.output(), (*top).ToString())
 		program.forthStack.Pop()
 	} else {
-		log.Fatal("Error: Attempted to print, but the stack is empty")
+		variant.Raise(variant.ErrStackUnderflow, "Attempted to print, but the stack is empty")
 	}
 }
 
@@ -144,46 +259,182 @@ func emitTop(program *ForthProgram) {
 		var top = program.forthStack.Top()
 		switch topCast := (*top).(type) {
 		case variant.ForthInt:
-			fmt.Printf("%c", rune(topCast))
+			fmt.Fprintf(program.output(), "%c", rune(topCast))
+			program.forthStack.Pop()
+		case variant.ForthChar:
+			fmt.Fprintf(program.output(), "%c", rune(topCast))
 			program.forthStack.Pop()
 		default:
-			log.Fatal("Error: emit failed to convert its argument")
+			variant.Raise(variant.ErrTypeMismatch, "emit failed to convert its argument (%v)", *top)
 		}
 	} else {
-		log.Fatal("Error: Attempted to emit, but the stack is empty")
+		variant.Raise(variant.ErrStackUnderflow, "Attempted to emit, but the stack is empty")
 	}
 }
 
 func drop(program *ForthProgram) {
+	requireDepth(program, 1, "drop")
 	program.forthStack.Pop()
 }
 
 func dup(program *ForthProgram) {
+	requireDepth(program, 1, "dup")
 	var top = *program.forthStack.Top()
-	program.forthStack.Push(top)
+	pushValue(program, top)
 }
 
 func swap(program *ForthProgram) {
+	requireDepth(program, 2, "swap")
 	program.forthStack.SwapTopElements()
 }
 
 func over(program *ForthProgram) {
+	requireDepth(program, 2, "over")
 	var second = *program.forthStack.Second()
-	program.forthStack.Push(second)
+	pushValue(program, second)
 }
 
 func rotate(program *ForthProgram) {
+	requireDepth(program, 3, "rot")
 	program.forthStack.RotateTopElements()
 }
 
+// pushValue raises a stack overflow when the data stack is at its maximum
+// depth.
+func pushValue(program *ForthProgram, value variant.Variant) {
+	if !program.forthStack.Push(value) {
+		variant.Raise(variant.ErrStackOverflow, "Stack depth limit of %d exceeded", program.forthStack.MaxDepth())
+	}
+}
+
+func requireDepth(program *ForthProgram, depth int, word string) {
+	if program.forthStack.Size() < depth {
+		variant.Raise(variant.ErrStackUnderflow, "'%s' needs %d stack elements, but the stack has %d", word, depth, program.forthStack.Size())
+	}
+}
+
+func formatStack(program *ForthProgram) string {
+	var elements = program.forthStack.Array()
+	var formatted = fmt.Sprintf("<%d> ", len(elements))
+	for i := len(elements) - 1; i >= 0; i-- {
+		formatted += fmt.Sprintf("%s ", elements[i].ToString())
+	}
+
+	return formatted
+}
+
+func printStack(program *ForthProgram) {
+	fmt.Fprint(program.output(), formatStack(program))
+}
+
+func stackDepth(program *ForthProgram) {
+	pushValue(program, variant.ForthInt(program.forthStack.Size()))
+}
+
+func clearStack(program *ForthProgram) {
+	program.forthStack.Clear()
+}
+
+func popIndex(program *ForthProgram, word string) int {
+	requireDepth(program, 1, word)
+	var index, isInt = (*program.forthStack.Top()).(variant.ForthInt)
+	if !isInt {
+		variant.Raise(variant.ErrTypeMismatch, "'%s' expects an integer index (got %v)", word, *program.forthStack.Top())
+	}
+
+	program.forthStack.Pop()
+	if index < 0 || int(index) >= program.forthStack.Size() {
+		variant.Raise(variant.ErrInvalidNumericArgument, "'%s' index %d is out of range for a stack of %d elements", word, index, program.forthStack.Size())
+	}
+
+	return int(index)
+}
+
+func pick(program *ForthProgram) {
+	var index = popIndex(program, "pick")
+	pushValue(program, *program.forthStack.Peek(index))
+}
+
+func roll(program *ForthProgram) {
+	var index = popIndex(program, "roll")
+	program.forthStack.Roll(index)
+}
+
+func reverseRotate(program *ForthProgram) {
+	requireDepth(program, 3, "-rot")
+	var top, _ = program.forthStack.Remove(0)
+	program.forthStack.Insert(2, top)
+}
+
+func nip(program *ForthProgram) {
+	requireDepth(program, 2, "nip")
+	program.forthStack.Remove(1)
+}
+
+func tuck(program *ForthProgram) {
+	requireDepth(program, 2, "tuck")
+	if !program.forthStack.Insert(2, *program.forthStack.Top()) {
+		variant.Raise(variant.ErrStackOverflow, "Stack depth limit of %d exceeded", program.forthStack.MaxDepth())
+	}
+}
+
+func dupIfTrue(program *ForthProgram) {
+	requireDepth(program, 1, "?dup")
+	var top = *program.forthStack.Top()
+	if top.AsBool() {
+		pushValue(program, top)
+	}
+}
+
+func dup2(program *ForthProgram) {
+	requireDepth(program, 2, "2dup")
+	pushValue(program, *program.forthStack.Peek(1))
+	pushValue(program, *program.forthStack.Peek(1))
+}
+
+func drop2(program *ForthProgram) {
+	requireDepth(program, 2, "2drop")
+	program.forthStack.Pop()
+	program.forthStack.Pop()
+}
+
+func swap2(program *ForthProgram) {
+	requireDepth(program, 4, "2swap")
+	program.forthStack.Roll(3)
+	program.forthStack.Roll(3)
+}
+
+func over2(program *ForthProgram) {
+	requireDepth(program, 4, "2over")
+	pushValue(program, *program.forthStack.Peek(3))
+	pushValue(program, *program.forthStack.Peek(3))
+}
+
+func rotate2(program *ForthProgram) {
+	requireDepth(program, 6, "2rot")
+	program.forthStack.Roll(5)
+	program.forthStack.Roll(5)
+}
+
+func isNil(program *ForthProgram) {
+	requireDepth(program, 1, "nil?")
+	var top, _ = program.forthStack.Pop()
+	var _, topIsNil = top.(variant.ForthNil)
+	pushValue(program, variant.ForthBool(topIsNil))
+}
+
+func bye(program *ForthProgram) {
+	variant.Raise(variant.ErrBye, "Bye")
+}
+
 func random(program *ForthProgram) {
-	var value = rand.Int64()
-	program.forthStack.Push(variant.ForthInt(value))
+	var value = program.random.Int64()
+	pushValue(program, variant.ForthInt(value))
 }
 
 func randomf(program *ForthProgram) {
-	var value = rand.Float64()
-	program.forthStack.Push(variant.ForthFloat(value))
+	var value = program.random.Float64()
+	pushValue(program, variant.ForthFloat(value))
 }
 
 func beginIf(program *ForthProgram) {
@@ -209,7 +460,7 @@ func loopAgain(program *ForthProgram) {
 	if topEntry != nil && !topEntry.isDoLoop {
 		program.wordIndex = topEntry.loopIndex
 	} else {
-		log.Fatalf("Error: Mismatched 'again'; no matching 'begin'")
+		variant.Raise(variant.ErrControlMismatch, "Mismatched 'again'; no matching 'begin'")
 	}
 }
 
@@ -220,17 +471,17 @@ func loopUntil(program *ForthProgram) {
 		program.StackPop()
 		if flag.AsBool() {
 			program.wordIndex = topEntry.loopIndex
+		} else {
+			program.loopStack.Pop()
 		}
 	} else {
-		log.Fatalf("Error: Mismatched 'until'; no matching 'begin'")
+		variant.Raise(variant.ErrControlMismatch, "Mismatched 'until'; no matching 'begin'")
 	}
 }
 
 func doLoopStart(program *ForthProgram) {
-	var lowerBound = (*program.StackTop()).(variant.ForthInt)
-	program.StackPop()
-	var upperBound = (*program.StackTop()).(variant.ForthInt)
-	program.StackPop()
+	var lowerBound = popInt(program, "do")
+	var upperBound = popInt(program, "do")
 	program.loopStack.Push(loopEntry{true, program.wordIndex, lowerBound, upperBound, lowerBound})
 }
 
@@ -240,36 +491,38 @@ func doLoopLoop(program *ForthProgram) {
 		if topEntry.currentValue < topEntry.upperBound-1 {
 			program.wordIndex = topEntry.loopIndex
 			topEntry.currentValue++
+		} else {
+			program.loopStack.Pop()
 		}
 	} else {
-		log.Fatalf("Error: Mismatched 'loop'; no matching 'do'")
+		variant.Raise(variant.ErrControlMismatch, "Mismatched 'loop'; no matching 'do'")
 	}
 }
 
 func loopIndex(program *ForthProgram) {
 	var topEntry = program.loopStack.Top()
 	if topEntry != nil && topEntry.isDoLoop {
-		program.forthStack.Push(variant.ForthInt(topEntry.currentValue))
+		pushValue(program, variant.ForthInt(topEntry.currentValue))
 	} else {
-		log.Fatalf("Error: 'i' has no corresponding loop to query")
+		variant.Raise(variant.ErrControlMismatch, "'i' has no corresponding loop to query")
 	}
 }
 
 func loopIndex2(program *ForthProgram) {
 	var topEntry = program.loopStack.Peek(1)
 	if topEntry != nil && topEntry.isDoLoop {
-		program.forthStack.Push(variant.ForthInt(topEntry.currentValue))
+		pushValue(program, variant.ForthInt(topEntry.currentValue))
 	} else {
-		log.Fatalf("Error: 'j' has no corresponding loop to query")
+		variant.Raise(variant.ErrControlMismatch, "'j' has no corresponding loop to query")
 	}
 }
 
 func loopIndex3(program *ForthProgram) {
 	var topEntry = program.loopStack.Peek(2)
 	if topEntry != nil && topEntry.isDoLoop {
-		program.forthStack.Push(variant.ForthInt(topEntry.currentValue))
+		pushValue(program, variant.ForthInt(topEntry.currentValue))
 	} else {
-		log.Fatalf("Error: 'k' has no corresponding loop to query")
+		variant.Raise(variant.ErrControlMismatch, "'k' has no corresponding loop to query")
 	}
 }
 
@@ -294,6 +547,52 @@ var binaryOperators = map[string]func(variant.Variant, variant.Variant) variant.
 	"xor": xor,
 }
 
+var checkedOperators = map[string]func(variant.Variant, variant.Variant) variant.Variant{
+	"+": variant.CheckedAdd,
+	"-": variant.CheckedSub,
+	"*": variant.CheckedMul,
+	"/": variant.CheckedDiv,
+}
+
+var promotingOperators = map[string]func(variant.Variant, variant.Variant) variant.Variant{
+	"+": variant.PromotingAdd,
+	"-": variant.PromotingSub,
+	"*": variant.PromotingMul,
+	"/": variant.PromotingDiv,
+}
+
+func binaryOperator(program *ForthProgram, word string) (func(variant.Variant, variant.Variant) variant.Variant, bool) {
+	var builtinFunction, found = builtinBinaryOperator(program, word)
+	if !found || len(program.variantTypes) == 0 {
+		return builtinFunction, found
+	}
+
+	return func(lhs variant.Variant, rhs variant.Variant) variant.Variant {
+		if registeredFunction, found := registeredOperator(program, word, lhs, rhs); found {
+			return registeredFunction(lhs, rhs)
+		}
+
+		return builtinFunction(lhs, rhs)
+	}, true
+}
+
+func builtinBinaryOperator(program *ForthProgram, word string) (func(variant.Variant, variant.Variant) variant.Variant, bool) {
+	if program.promoteBigInts {
+		if promotingFunction, found := promotingOperators[word]; found {
+			return promotingFunction, true
+		}
+	}
+
+	if program.checkedArithmetic {
+		if checkedFunction, found := checkedOperators[word]; found {
+			return checkedFunction, true
+		}
+	}
+
+	var binOpFunction, found = binaryOperators[word]
+	return binOpFunction, found
+}
+
 var unaryOperators = map[string]func(variant.Variant) variant.Variant{
 	"not": not,
 }
@@ -308,8 +607,25 @@ var builtinFunctions = map[string]func(*ForthProgram){
 	"over":  over,
 	"rot":   rotate,
 	"rand":  random,
+	"bye":   bye,
 	"randf": randomf,
 
+	".s":    printStack,
+	"depth": stackDepth,
+	"clear": clearStack,
+	"pick":  pick,
+	"roll":  roll,
+	"-rot":  reverseRotate,
+	"nip":   nip,
+	"tuck":  tuck,
+	"?dup":  dupIfTrue,
+	"2dup":  dup2,
+	"2drop": drop2,
+	"2swap": swap2,
+	"2over": over2,
+	"2rot":  rotate2,
+	"nil?":  isNil,
+
 	"if":   beginIf,
 	"else": beginElse,
 	"then": endIf,
@@ -324,47 +640,136 @@ var builtinFunctions = map[string]func(*ForthProgram){
 	"k":     loopIndex3,
 }
 
+// isSkipped reports whether word falls in the branch of an IF that isn't
+// being taken.
+func isSkipped(program *ForthProgram, wordLower string) bool {
+	if program.branchStack.IsEmpty() || wordLower == "else" || wordLower == "then" {
+		return false
+	}
+
+	return program.branchStack.Top().condition == program.branchStack.Top().inElse
+}
+
 func ExecuteWord(program *ForthProgram, word string) {
 	var wordLower = strings.ToLower(word)
-	if program.branchStack.IsEmpty() || program.branchStack.Top().condition != program.branchStack.Top().inElse || wordLower == "else" || wordLower == "then" {
+	if !isSkipped(program, wordLower) {
 		if integer, err := strconv.Atoi(word); err == nil {
-			program.forthStack.Push(variant.ForthInt(integer))
-		} else if float, err := strconv.ParseFloat(word, 64); err == nil {
-			program.forthStack.Push(variant.ForthFloat(float))
+			pushValue(program, variant.ForthInt(integer))
+		} else if bigInteger, ok := new(big.Int).SetString(word, 10); ok {
+			pushValue(program, variant.NewForthBigInt(bigInteger))
+		} else if rational, ok := variant.ParseForthRational(word); ok {
+			pushValue(program, rational)
+		} else if decimal, ok := variant.ParseForthDecimal(word); ok {
+			pushValue(program, decimal)
+		} else if float, ok := parseFloatLiteral(program, word); ok {
+			pushFloat(program, float)
+		} else if complexValue, ok := variant.ParseForthComplex(word); ok {
+			pushValue(program, complexValue)
+		} else if char, ok := variant.ParseForthChar(word); ok {
+			pushValue(program, char)
 		} else if strings.HasPrefix(word, `"`) && strings.HasSuffix(word, `"`) {
 			var str = strings.TrimPrefix(word, `"`)
 			str = strings.TrimSuffix(str, `"`)
-			program.forthStack.Push(variant.ForthString(str))
-		} else if binOpFunction, found := binaryOperators[wordLower]; found {
-			var rhs = *program.forthStack.Top()
-			program.forthStack.Pop()
-			var lhs = *program.forthStack.Top()
-			program.forthStack.Pop()
-			program.forthStack.Push(binOpFunction(lhs, rhs))
+			pushValue(program, variant.ForthString(str))
+		} else if binOpFunction, found := binaryOperator(program, wordLower); found {
+			requireDepth(program, 2, wordLower)
+			var rhs, _ = program.forthStack.Pop()
+			var lhs, _ = program.forthStack.Pop()
+			pushValue(program, binOpFunction(lhs, rhs))
 		} else if unOpFunction, found := unaryOperators[wordLower]; found {
-			var operand = *program.forthStack.Top()
-			program.forthStack.Pop()
-			program.forthStack.Push(unOpFunction(operand))
+			requireDepth(program, 1, wordLower)
+			var operand, _ = program.forthStack.Pop()
+			pushValue(program, unOpFunction(operand))
 		} else if builtinFunction, found := builtinFunctions[wordLower]; found {
+			if capability, restricted := wordCapabilities[wordLower]; restricted {
+				checkPermitted(program, wordLower, capability)
+			}
+
 			builtinFunction(program)
+		} else if hostFunction, found := program.hostWords[wordLower]; found {
+			checkPermitted(program, wordLower, CapabilityHost)
+			hostFunction(program)
 		} else if definedWord, found := program.definedWords[word]; found {
-			for _, subWord := range definedWord {
-				ExecuteWord(program, subWord)
-			}
+			executeWords(program, definedWord)
 		} else {
 			switch word {
 			case "true":
-				program.forthStack.Push(variant.ForthBool(true))
+				pushValue(program, variant.ForthBool(true))
 			case "false":
-				program.forthStack.Push(variant.ForthBool(false))
+				pushValue(program, variant.ForthBool(false))
+			case "nil":
+				pushValue(program, variant.ForthNil{})
 			default:
-				log.Fatalf("Error: Unrecognized word '%s'", word)
+				if value, found := parseRegisteredLiteral(program, word); found {
+					pushValue(program, value)
+				} else {
+					variant.Raise(variant.ErrUndefinedWord, "Unrecognized word '%s'", word)
+				}
 			}
 		}
 	}
 }
 
-func ExecuteWordLine(program *ForthProgram, wordLine string) {
+func endDefinition(program *ForthProgram) {
+	var words = program.definition[:len(program.definition)-1]
+	program.compiling, program.definition = false, nil
+	if len(words) == 0 {
+		variant.Raise(variant.ErrZeroLengthName, "Definition is missing a name")
+	}
+
+	defineWord(program, words[0], words[1:])
+}
+
+func executeWords(program *ForthProgram, words []string) {
+	var outerWords, outerIndex = program.currentWords, program.wordIndex
+	program.currentWords, program.wordIndex = words, 0
+	for program.wordIndex < len(words) {
+		var start, word = program.wordIndex, words[program.wordIndex]
+		var traced = program.trace != nil && !isSkipped(program, strings.ToLower(word))
+		ExecuteWord(program, word)
+		if traced {
+			if program.wordIndex > start {
+				word = strings.Join(words[start:program.wordIndex+1], " ")
+			}
+
+			traceWord(program, word)
+		}
+
+		checkLimits(program)
+		program.wordIndex++
+	}
+
+	program.currentWords, program.wordIndex = outerWords, outerIndex
+}
+
+func ExecuteWordLine(program *ForthProgram, wordLine string) (err error) {
+	defer program.beginExecution()()
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			var forthError, isForthError = recovered.(*variant.ForthError)
+			if !isForthError {
+				panic(recovered)
+			}
+
+			resetExecution(program)
+			err = forthError
+		}
+	}()
+
+	executeLine(program, wordLine)
+	return nil
+}
+
+func resetExecution(program *ForthProgram) {
+	program.loopStack.Clear()
+	program.branchStack.Clear()
+	program.listStarts.Clear()
+	program.currentWords, program.wordIndex = nil, 0
+}
+
+// executeLine runs one line of source without recovering from errors, so a
+// caller further up can still CATCH them with its own state intact.
+func executeLine(program *ForthProgram, wordLine string) {
 	wordLine = strings.TrimSpace(wordLine)
 
 	var inQuotes = false
@@ -377,14 +782,32 @@ func ExecuteWordLine(program *ForthProgram, wordLine string) {
 		return !inQuotes && unicode.IsSpace(r)
 	})
 
-	if len(inputSplit) >= 4 && inputSplit[0] == ":" && inputSplit[len(inputSplit)-1] == ";" {
-		program.definedWords[inputSplit[1]] = inputSplit[2 : len(inputSplit)-1]
-	} else {
-		program.wordIndex = 0
-		for program.wordIndex < len(inputSplit) {
-			var thisWord = inputSplit[program.wordIndex]
-			ExecuteWord(program, thisWord)
-			program.wordIndex++
+	if program.compiling {
+		program.definition = append(program.definition, inputSplit...)
+		if len(inputSplit) > 0 && inputSplit[len(inputSplit)-1] == ";" {
+			endDefinition(program)
 		}
+	} else if len(inputSplit) >= 4 && inputSplit[0] == ":" && inputSplit[len(inputSplit)-1] == ";" {
+		defineWord(program, inputSplit[1], inputSplit[2:len(inputSplit)-1])
+	} else if len(inputSplit) > 0 && inputSplit[0] == ":" && inputSplit[len(inputSplit)-1] != ";" {
+		program.compiling, program.definition = true, slices.Clone(inputSplit[1:])
+	} else {
+		executeWords(program, inputSplit)
+	}
+}
+
+// unterminatedDefinition reports a ':' definition left open at the end of a
+// source, and closes it so it can't swallow whatever is executed next.
+func unterminatedDefinition(program *ForthProgram, source string) *variant.ForthError {
+	if !program.compiling {
+		return nil
+	}
+
+	var name = "(unnamed)"
+	if len(program.definition) > 0 {
+		name = program.definition[0]
 	}
+
+	program.compiling, program.definition = false, nil
+	return variant.NewError(variant.ErrControlMismatch, "Unterminated definition of '%s' at the end of %s", name, source)
 }
@@ -0,0 +1,62 @@
+package forth
+
+import (
+	"errors"
+	"io/fs"
+	"maps"
+	"os"
+
+	"goforth/variant"
+)
+
+func popString(program *ForthProgram, word string) string {
+	requireDepth(program, 1, word)
+	var top, _ = program.forthStack.Pop()
+	if str, isString := top.(variant.ForthString); isString {
+		return string(str)
+	}
+
+	variant.Raise(variant.ErrTypeMismatch, "'%s' expects a string (got %v)", word, top)
+	return ""
+}
+
+func raiseFileError(err error, word string) {
+	if errors.Is(err, fs.ErrNotExist) {
+		panic(&variant.ForthError{Code: variant.ErrNonExistentFile, Message: "'" + word + "' failed: " + err.Error(), Cause: err})
+	}
+
+	panic(&variant.ForthError{Code: variant.ErrFileIO, Message: "'" + word + "' failed: " + err.Error(), Cause: err})
+}
+
+func readFile(program *ForthProgram) {
+	var contents, err = os.ReadFile(popString(program, "read-file"))
+	if err != nil {
+		raiseFileError(err, "read-file")
+	}
+
+	pushValue(program, variant.ForthString(contents))
+}
+
+func writeFile(program *ForthProgram) {
+	var path = popString(program, "write-file")
+	var contents = popString(program, "write-file")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		raiseFileError(err, "write-file")
+	}
+}
+
+func fileExists(program *ForthProgram) {
+	var _, err = os.Stat(popString(program, "file-exists?"))
+	pushValue(program, variant.ForthBool(err == nil))
+}
+
+var fsFunctions = map[string]func(*ForthProgram){
+	"read-file":    readFile,
+	"write-file":   writeFile,
+	"file-exists?": fileExists,
+}
+
+func init() {
+	maps.Copy(builtinFunctions, fsFunctions)
+	requireCapability(fsFunctions, CapabilityFS)
+}
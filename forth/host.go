@@ -0,0 +1,262 @@
+package forth
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"goforth/variant"
+)
+
+var (
+	errorType   = reflect.TypeFor[error]()
+	variantType = reflect.TypeFor[variant.Variant]()
+)
+
+func checkHostWordName(program *ForthProgram, name string) error {
+	var nameLower = strings.ToLower(name)
+	if name == "" || strings.IndexFunc(name, unicode.IsSpace) >= 0 {
+		return fmt.Errorf("invalid host word name %q", name)
+	}
+
+	if _, found := binaryOperators[nameLower]; found {
+		return fmt.Errorf("host word '%s' would shadow a builtin", name)
+	} else if _, found := unaryOperators[nameLower]; found {
+		return fmt.Errorf("host word '%s' would shadow a builtin", name)
+	} else if _, found := builtinFunctions[nameLower]; found {
+		return fmt.Errorf("host word '%s' would shadow a builtin", name)
+	} else if _, found := program.hostWords[nameLower]; found {
+		return fmt.Errorf("host word '%s' is already registered", name)
+	}
+
+	for defined := range program.definedWords {
+		if strings.ToLower(defined) == nameLower {
+			return fmt.Errorf("host word '%s' would shadow the definition of '%s'", name, defined)
+		}
+	}
+
+	return nil
+}
+
+// defineWord refuses names taken by host words, which are looked up first
+// and would otherwise hide the new definition.
+func defineWord(program *ForthProgram, name string, words []string) {
+	if _, found := program.hostWords[strings.ToLower(name)]; found {
+		variant.Raise(variant.ErrHostWordRedefined, "Can't redefine host word '%s'", name)
+	}
+
+	program.definedWords[name] = words
+}
+
+func raiseHostError(name string, err error) {
+	var forthError *variant.ForthError
+	if errors.As(err, &forthError) {
+		panic(forthError)
+	}
+
+	variant.Raise(variant.ErrAbort, "'%s' failed: %v", name, err)
+}
+
+func (program *ForthProgram) RegisterWord(name string, inputs int, outputs int, function func(args []variant.Variant) ([]variant.Variant, error)) error {
+	if err := checkHostWordName(program, name); err != nil {
+		return err
+	} else if inputs < 0 || outputs < 0 {
+		return fmt.Errorf("host word '%s' has a negative stack effect (%d -- %d)", name, inputs, outputs)
+	}
+
+	program.hostWords[strings.ToLower(name)] = func(program *ForthProgram) {
+		requireDepth(program, inputs, name)
+		var args = make([]variant.Variant, inputs)
+		for i := inputs - 1; i >= 0; i-- {
+			args[i], _ = program.forthStack.Pop()
+		}
+
+		var results, err = function(args)
+		if err != nil {
+			raiseHostError(name, err)
+		} else if len(results) != outputs {
+			variant.Raise(variant.ErrAbort, "'%s' returned %d values but declares %d", name, len(results), outputs)
+		}
+
+		for _, result := range results {
+			if result == nil {
+				result = variant.ForthNil{}
+			}
+
+			pushValue(program, result)
+		}
+	}
+
+	return nil
+}
+
+func (program *ForthProgram) RegisterFunc(name string, function any) error {
+	var functionValue = reflect.ValueOf(function)
+	if function == nil || functionValue.Kind() != reflect.Func || functionValue.IsNil() {
+		return fmt.Errorf("host word '%s' must be bound to a function", name)
+	}
+
+	var functionType = functionValue.Type()
+	if functionType.IsVariadic() {
+		return fmt.Errorf("host word '%s' can't be variadic", name)
+	}
+
+	var outputs = functionType.NumOut()
+	var returnsError = outputs > 0 && functionType.Out(outputs-1) == errorType
+	if returnsError {
+		outputs--
+	}
+
+	for i := range functionType.NumIn() {
+		if !isHostType(functionType.In(i)) {
+			return fmt.Errorf("host word '%s' has an unsupported parameter type %v", name, functionType.In(i))
+		}
+	}
+
+	for i := range outputs {
+		if !isHostType(functionType.Out(i)) {
+			return fmt.Errorf("host word '%s' has an unsupported result type %v", name, functionType.Out(i))
+		}
+	}
+
+	return program.RegisterWord(name, functionType.NumIn(), outputs, func(args []variant.Variant) ([]variant.Variant, error) {
+		var in = make([]reflect.Value, len(args))
+		for i, arg := range args {
+			in[i] = fromVariant(name, i, arg, functionType.In(i))
+		}
+
+		var out = functionValue.Call(in)
+		if returnsError {
+			if errValue := out[len(out)-1]; !errValue.IsNil() {
+				return nil, errValue.Interface().(error)
+			}
+
+			out = out[:len(out)-1]
+		}
+
+		var results = make([]variant.Variant, len(out))
+		for i, value := range out {
+			results[i] = toVariant(value)
+		}
+
+		return results, nil
+	})
+}
+
+func isHostType(hostType reflect.Type) bool {
+	if hostType.Implements(variantType) {
+		return true
+	}
+
+	switch hostType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.String, reflect.Bool:
+		return true
+	default:
+		return false
+	}
+}
+
+func fromVariant(name string, index int, arg variant.Variant, hostType reflect.Type) reflect.Value {
+	var converted = reflect.New(hostType).Elem()
+	if hostType == variantType {
+		converted.Set(reflect.ValueOf(&arg).Elem())
+		return converted
+	} else if hostType.Implements(variantType) {
+		if reflect.TypeOf(arg) != hostType {
+			raiseHostArgument(name, index, arg, hostType)
+		}
+
+		return reflect.ValueOf(arg)
+	}
+
+	switch hostType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var integer = hostInteger(name, index, arg, hostType)
+		if converted.OverflowInt(int64(integer)) {
+			variant.Raise(variant.ErrResultOutOfRange, "'%s' argument %d is out of range for %v (%v)", name, index+1, hostType, arg)
+		}
+
+		converted.SetInt(int64(integer))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var integer = hostInteger(name, index, arg, hostType)
+		if integer < 0 || converted.OverflowUint(uint64(integer)) {
+			variant.Raise(variant.ErrResultOutOfRange, "'%s' argument %d is out of range for %v (%v)", name, index+1, hostType, arg)
+		}
+
+		converted.SetUint(uint64(integer))
+	case reflect.Float32, reflect.Float64:
+		switch arg.(type) {
+		case variant.ForthInt, variant.ForthBigInt, variant.ForthRational, variant.ForthDecimal, variant.ForthFloat:
+			var float, _ = arg.ToFloat()
+			converted.SetFloat(float64(float))
+		default:
+			raiseHostArgument(name, index, arg, hostType)
+		}
+	case reflect.String:
+		if str, isString := arg.(variant.ForthString); isString {
+			converted.SetString(string(str))
+		} else {
+			raiseHostArgument(name, index, arg, hostType)
+		}
+	case reflect.Bool:
+		if boolean, isBool := arg.(variant.ForthBool); isBool {
+			converted.SetBool(bool(boolean))
+		} else {
+			raiseHostArgument(name, index, arg, hostType)
+		}
+	}
+
+	return converted
+}
+
+func hostInteger(name string, index int, arg variant.Variant, hostType reflect.Type) variant.ForthInt {
+	switch arg.(type) {
+	case variant.ForthInt, variant.ForthBigInt:
+		var integer, err = arg.ToInt()
+		if err != nil {
+			variant.Raise(variant.ErrResultOutOfRange, "'%s' argument %d is out of range for %v (%v)", name, index+1, hostType, arg)
+		}
+
+		return integer
+	default:
+		raiseHostArgument(name, index, arg, hostType)
+		return 0
+	}
+}
+
+func raiseHostArgument(name string, index int, arg variant.Variant, hostType reflect.Type) {
+	variant.Raise(variant.ErrTypeMismatch, "'%s' argument %d expects %v (got %v)", name, index+1, hostType, arg)
+}
+
+func toVariant(value reflect.Value) variant.Variant {
+	if value.Type().Implements(variantType) {
+		if value.Kind() == reflect.Interface && value.IsNil() {
+			return variant.ForthNil{}
+		}
+
+		return value.Interface().(variant.Variant)
+	}
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return variant.ForthInt(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if value.Uint() > math.MaxInt64 {
+			return variant.FromBigInt(new(big.Int).SetUint64(value.Uint()))
+		}
+
+		return variant.ForthInt(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		return variant.ForthFloat(value.Float())
+	case reflect.String:
+		return variant.ForthString(value.String())
+	default:
+		return variant.ForthBool(value.Bool())
+	}
+}
@@ -0,0 +1,198 @@
+package forth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"goforth/variant"
+)
+
+type includeFrame struct {
+	path string
+	key  string
+	line int
+}
+
+// includeLocation marks an error that already carries its include chain, so
+// the files that included the failing one pass it on unchanged.
+type includeLocation struct {
+	cause error
+}
+
+func (location includeLocation) Error() string {
+	return location.cause.Error()
+}
+
+func (location includeLocation) Unwrap() error {
+	return location.cause
+}
+
+// ExecuteFile runs a source file as INCLUDED would, so relative INCLUDEs in
+// it resolve against its directory and errors report the include chain.
+// Unlike the words, it doesn't need CapabilityFS.
+func (program *ForthProgram) ExecuteFile(ctx context.Context, path string) (err error) {
+	defer program.useContext(ctx)()
+	defer program.beginExecution()()
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			var forthError, isForthError = recovered.(*variant.ForthError)
+			if !isForthError {
+				panic(recovered)
+			}
+
+			resetExecution(program)
+			err = forthError
+		}
+	}()
+
+	includeFile(program, path, "included")
+	return nil
+}
+
+// resolveInclude makes a relative path relative to the directory of the file
+// being included, or to the working directory outside of any file.
+func resolveInclude(program *ForthProgram, path string) string {
+	if filepath.IsAbs(path) || len(program.includes) == 0 {
+		return filepath.Clean(path)
+	}
+
+	return filepath.Join(filepath.Dir(program.includes[len(program.includes)-1].path), path)
+}
+
+func includeKey(path string) string {
+	if absolute, err := filepath.Abs(path); err == nil {
+		return absolute
+	}
+
+	return path
+}
+
+func includeFile(program *ForthProgram, path string, word string) {
+	var resolved = resolveInclude(program, path)
+	var key = includeKey(resolved)
+	for i, frame := range program.includes {
+		if frame.key == key {
+			var cycle []string
+			for _, frame := range program.includes[i:] {
+				cycle = append(cycle, frame.path)
+			}
+
+			variant.Raise(variant.ErrIncludeCycle, "Include cycle: %s -> %s", strings.Join(cycle, " -> "), resolved)
+		}
+	}
+
+	var contents, err = os.ReadFile(resolved)
+	if err != nil {
+		raiseFileError(err, word)
+	}
+
+	program.included[key] = true
+	program.includes = append(program.includes, includeFrame{path: resolved, key: key})
+	defer func() {
+		program.includes = program.includes[:len(program.includes)-1]
+	}()
+
+	var lines = strings.Split(string(contents), "\n")
+	if strings.HasPrefix(lines[0], "#!") {
+		lines[0] = ""
+	}
+
+	for i, line := range lines {
+		program.includes[len(program.includes)-1].line = i + 1
+		if program.ctx != nil && program.ctx.Err() != nil {
+			panic(locateIncludeError(program, interruption(program.ctx)))
+		}
+
+		executeIncludedLine(program, line)
+	}
+
+	if err := unterminatedDefinition(program, resolved); err != nil {
+		panic(locateIncludeError(program, err))
+	}
+}
+
+// executeIncludedLine adds the include chain to any error without resetting
+// the includer's loops and branches, which a surrounding CATCH still needs.
+func executeIncludedLine(program *ForthProgram, line string) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			var forthError, isForthError = recovered.(*variant.ForthError)
+			if !isForthError {
+				panic(recovered)
+			}
+
+			panic(locateIncludeError(program, forthError))
+		}
+	}()
+
+	executeLine(program, line)
+}
+
+func locateIncludeError(program *ForthProgram, err error) *variant.ForthError {
+	var forthError *variant.ForthError
+	errors.As(err, &forthError)
+	if errors.As(err, &includeLocation{}) {
+		return forthError
+	}
+
+	var message strings.Builder
+	message.WriteString(forthError.Message)
+	for i := len(program.includes) - 1; i >= 0; i-- {
+		var frame = program.includes[i]
+		if i == len(program.includes)-1 {
+			fmt.Fprintf(&message, "\n  at %s:%d", frame.path, frame.line)
+		} else {
+			fmt.Fprintf(&message, "\n  included from %s:%d", frame.path, frame.line)
+		}
+	}
+
+	return &variant.ForthError{Code: forthError.Code, Message: message.String(), Cause: includeLocation{forthError}}
+}
+
+func includeName(program *ForthProgram, word string) string {
+	var name = nextWord(program, word)
+	if len(name) >= 2 && strings.HasPrefix(name, `"`) && strings.HasSuffix(name, `"`) {
+		name = name[1 : len(name)-1]
+	}
+
+	return name
+}
+
+func requireFile(program *ForthProgram, path string, word string) {
+	if !program.included[includeKey(resolveInclude(program, path))] {
+		includeFile(program, path, word)
+	}
+}
+
+func include(program *ForthProgram) {
+	includeFile(program, includeName(program, "include"), "include")
+}
+
+func included(program *ForthProgram) {
+	includeFile(program, popString(program, "included"), "included")
+}
+
+func require(program *ForthProgram) {
+	requireFile(program, includeName(program, "require"), "require")
+}
+
+func required(program *ForthProgram) {
+	requireFile(program, popString(program, "required"), "required")
+}
+
+var includeFunctions = map[string]func(*ForthProgram){
+	"include":  include,
+	"included": included,
+	"require":  require,
+	"required": required,
+}
+
+func init() {
+	maps.Copy(builtinFunctions, includeFunctions)
+	requireCapability(includeFunctions, CapabilityFS)
+}
@@ -0,0 +1,49 @@
+package forth
+
+import (
+	"errors"
+	"io"
+	"maps"
+	"strings"
+
+	"goforth/variant"
+)
+
+func raiseReadError(err error, word string) {
+	variant.Raise(variant.ErrAbort, "'%s' failed to read input: %v", word, err)
+}
+
+func key(program *ForthProgram) {
+	var char, _, err = program.stdin.ReadRune()
+	if errors.Is(err, io.EOF) {
+		pushValue(program, variant.ForthNil{})
+		return
+	} else if err != nil {
+		raiseReadError(err, "key")
+	}
+
+	pushValue(program, variant.ForthChar(char))
+}
+
+func accept(program *ForthProgram) {
+	var line, err = program.stdin.ReadString('\n')
+	if errors.Is(err, io.EOF) && line == "" {
+		pushValue(program, variant.ForthNil{})
+		return
+	} else if err != nil && !errors.Is(err, io.EOF) {
+		raiseReadError(err, "accept")
+	}
+
+	line = strings.TrimSuffix(line, "\n")
+	pushValue(program, variant.ForthString(strings.TrimSuffix(line, "\r")))
+}
+
+var inputFunctions = map[string]func(*ForthProgram){
+	"key":    key,
+	"accept": accept,
+}
+
+func init() {
+	maps.Copy(builtinFunctions, inputFunctions)
+	requireCapability(inputFunctions, CapabilityIO)
+}
@@ -0,0 +1,204 @@
+package forth
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"goforth/variant"
+)
+
+// Limits are checked after every executed word. The data stack is only
+// measured in full every memoryCheckInterval steps; in between just the top
+// element is measured, which is where a runaway value grows.
+const memoryCheckInterval = 256
+
+type limits struct {
+	maxSteps      int
+	maxStackDepth int
+	maxMemory     int
+	maxOutput     int
+}
+
+type usage struct {
+	steps  int
+	output int
+}
+
+func WithMaxSteps(steps int) ProgramOption {
+	return func(program *ForthProgram) {
+		program.limits.maxSteps = steps
+	}
+}
+
+// WithMaxStackDepth bounds each stack as it is pushed, and the two stacks
+// together after every word.
+func WithMaxStackDepth(depth int) ProgramOption {
+	return func(program *ForthProgram) {
+		program.limits.maxStackDepth = depth
+		program.forthStack.SetMaxDepth(depth)
+		program.floatStack.SetMaxDepth(depth)
+	}
+}
+
+func WithMaxMemory(bytes int) ProgramOption {
+	return func(program *ForthProgram) {
+		program.limits.maxMemory = bytes
+	}
+}
+
+func WithMaxOutput(bytes int) ProgramOption {
+	return func(program *ForthProgram) {
+		program.limits.maxOutput = bytes
+	}
+}
+
+func (program *ForthProgram) beginExecution() func() {
+	if program.executionDepth == 0 {
+		program.usage = usage{}
+	}
+
+	program.executionDepth++
+	return func() {
+		program.executionDepth--
+	}
+}
+
+func (program *ForthProgram) useContext(ctx context.Context) func() {
+	var outerContext = program.ctx
+	program.ctx = ctx
+	return func() {
+		program.ctx = outerContext
+	}
+}
+
+func (program *ForthProgram) ExecuteContext(ctx context.Context, source string) error {
+	defer program.useContext(ctx)()
+	defer program.beginExecution()()
+	for _, line := range strings.Split(source, "\n") {
+		if ctx.Err() != nil {
+			return interruption(ctx)
+		}
+
+		if err := ExecuteWordLine(program, line); err != nil {
+			return err
+		}
+	}
+
+	if err := unterminatedDefinition(program, "the source"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ExecuteLineContext runs a single line of interactive input. Unlike
+// ExecuteContext it lets a ':' definition stay open for the next line.
+func (program *ForthProgram) ExecuteLineContext(ctx context.Context, line string) error {
+	defer program.useContext(ctx)()
+	if ctx.Err() != nil {
+		return interruption(ctx)
+	}
+
+	return ExecuteWordLine(program, line)
+}
+
+func interruption(ctx context.Context) *variant.ForthError {
+	return &variant.ForthError{Code: variant.ErrUserInterrupt, Message: "Interrupted: " + ctx.Err().Error(), Cause: ctx.Err()}
+}
+
+func checkLimits(program *ForthProgram) {
+	program.usage.steps++
+	if program.ctx != nil {
+		select {
+		case <-program.ctx.Done():
+			panic(interruption(program.ctx))
+		default:
+		}
+	}
+
+	if program.limits.maxSteps > 0 && program.usage.steps > program.limits.maxSteps {
+		variant.Raise(variant.ErrStepLimitExceeded, "Step limit of %d exceeded", program.limits.maxSteps)
+	}
+
+	if program.limits.maxStackDepth > 0 && program.forthStack.Size()+program.floatStack.Size() > program.limits.maxStackDepth {
+		variant.Raise(variant.ErrStackOverflow, "Stack depth limit of %d exceeded", program.limits.maxStackDepth)
+	}
+
+	if program.limits.maxMemory > 0 {
+		var used int
+		if program.usage.steps%memoryCheckInterval == 0 {
+			used = stackMemory(program)
+		} else if top := program.forthStack.Top(); top != nil {
+			used = sizeOf(*top)
+		}
+
+		if used > program.limits.maxMemory {
+			variant.Raise(variant.ErrMemoryLimitExceeded, "Memory limit of %d bytes exceeded", program.limits.maxMemory)
+		}
+	}
+}
+
+func stackMemory(program *ForthProgram) int {
+	var used = (program.floatStack.Size() + len(program.floatCells)) * 8
+	for _, value := range program.forthStack.Array() {
+		used += sizeOf(value)
+	}
+
+	return used
+}
+
+func sizeOf(value variant.Variant) int {
+	const header = 16
+	switch valueCast := value.(type) {
+	case variant.ForthString:
+		return header + len(valueCast)
+	case variant.ForthXt:
+		return header + len(valueCast)
+	case variant.ForthBigInt:
+		return header + len(valueCast.BigInt().Bits())*8
+	case variant.ForthRational:
+		return header + len(valueCast.Rat().Num().Bits())*8 + len(valueCast.Rat().Denom().Bits())*8
+	case variant.ForthDecimal:
+		return header + len(valueCast.Rat().Num().Bits())*8
+	case variant.ForthList:
+		var size = header
+		for _, element := range valueCast {
+			size += sizeOf(element)
+		}
+
+		return size
+	case variant.ForthMap:
+		var size = header
+		for _, key := range valueCast.Keys() {
+			var element, _ = valueCast.Get(key)
+			size += sizeOf(key) + sizeOf(element)
+		}
+
+		return size
+	default:
+		return header
+	}
+}
+
+type outputCounter struct {
+	program *ForthProgram
+}
+
+func (program *ForthProgram) output() io.Writer {
+	return outputCounter{program}
+}
+
+func (counter outputCounter) Write(bytes []byte) (int, error) {
+	var program = counter.program
+	var limit = program.limits.maxOutput
+	if limit > 0 && program.usage.output+len(bytes) > limit {
+		var allowed = max(limit-program.usage.output, 0)
+		program.usage.output += allowed
+		program.stdout.Write(bytes[:allowed])
+		variant.Raise(variant.ErrOutputLimitExceeded, "Output limit of %d bytes exceeded", limit)
+	}
+
+	program.usage.output += len(bytes)
+	return program.stdout.Write(bytes)
+}
@@ -0,0 +1,238 @@
+package forth
+
+import (
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"goforth/variant"
+)
+
+func popList(program *ForthProgram, word string) variant.ForthList {
+	requireDepth(program, 1, word)
+	var top, _ = program.forthStack.Pop()
+	if list, isList := top.(variant.ForthList); isList {
+		return list
+	}
+
+	variant.Raise(variant.ErrTypeMismatch, "'%s' expects a list (got %v)", word, top)
+	return nil
+}
+
+func listIndex(list variant.ForthList, index variant.ForthInt, word string, allowEnd bool) int {
+	var limit = len(list)
+	if allowEnd {
+		limit++
+	}
+
+	if index < 0 || int(index) >= limit {
+		variant.Raise(variant.ErrInvalidNumericArgument, "'%s' index %d is out of range for a list of %d elements", word, index, len(list))
+	}
+
+	return int(index)
+}
+
+// callXt runs xt on arguments and expects it to consume them and leave
+// exactly one result.
+func callXt(program *ForthProgram, xt variant.ForthXt, word string, arguments ...variant.Variant) variant.Variant {
+	var depth = program.forthStack.Size()
+	for _, argument := range arguments {
+		pushValue(program, argument)
+	}
+
+	ExecuteWord(program, string(xt))
+	if results := program.forthStack.Size() - depth; results < 1 {
+		variant.Raise(variant.ErrStackUnderflow, "'%s' expects '%s' to leave a result, but it left none", word, xt)
+	} else if results > 1 {
+		variant.Raise(variant.ErrTypeMismatch, "'%s' expects '%s' to leave one result, but it left %d", word, xt, results)
+	}
+
+	var result, _ = program.forthStack.Pop()
+	return result
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////
+
+func beginList(program *ForthProgram) {
+	program.listStarts.Push(program.forthStack.Size())
+}
+
+func endList(program *ForthProgram) {
+	var start, ok = program.listStarts.Pop()
+	if !ok {
+		variant.Raise(variant.ErrControlMismatch, "Mismatched '}'; no matching '{'")
+	}
+
+	if start > program.forthStack.Size() {
+		variant.Raise(variant.ErrStackUnderflow, "List elements were consumed from below its '{'")
+	}
+
+	var list = make(variant.ForthList, program.forthStack.Size()-start)
+	for i := len(list) - 1; i >= 0; i-- {
+		list[i], _ = program.forthStack.Pop()
+	}
+
+	pushValue(program, list)
+}
+
+func length(program *ForthProgram) {
+	requireDepth(program, 1, "length")
+	var top, _ = program.forthStack.Pop()
+	switch topCast := top.(type) {
+	case variant.ForthList:
+		pushValue(program, variant.ForthInt(len(topCast)))
+	case variant.ForthString:
+		pushValue(program, variant.ForthInt(len([]rune(topCast))))
+	default:
+		variant.Raise(variant.ErrTypeMismatch, "'length' expects a list or string (got %v)", top)
+	}
+}
+
+func find(program *ForthProgram) {
+	requireDepth(program, 2, "find")
+	var needle, _ = program.forthStack.Pop()
+	var haystack, _ = program.forthStack.Pop()
+	switch haystackCast := haystack.(type) {
+	case variant.ForthString:
+		var needleString, isString = needle.(variant.ForthString)
+		if needleChar, isChar := needle.(variant.ForthChar); isChar {
+			needleString, isString = variant.ForthString(needleChar.String()), true
+		}
+
+		if !isString {
+			variant.Raise(variant.ErrTypeMismatch, "'find' expects a string to search for in a string (got %v)", needle)
+		}
+
+		if index := strings.Index(string(haystackCast), string(needleString)); index >= 0 {
+			pushValue(program, variant.ForthInt(utf8.RuneCountInString(string(haystackCast[:index]))))
+			return
+		}
+	case variant.ForthList:
+		if index := slices.IndexFunc(haystackCast, func(element variant.Variant) bool { return variant.Equal(element, needle) }); index >= 0 {
+			pushValue(program, variant.ForthInt(index))
+			return
+		}
+	default:
+		variant.Raise(variant.ErrTypeMismatch, "'find' expects a list or string (got %v)", haystack)
+	}
+
+	pushValue(program, variant.ForthNil{})
+}
+
+func nth(program *ForthProgram) {
+	var index = popInt(program, "nth")
+	var list = popList(program, "nth")
+	pushValue(program, list[listIndex(list, index, "nth", false)])
+}
+
+func setNth(program *ForthProgram) {
+	requireDepth(program, 3, "set-nth")
+	var value, _ = program.forthStack.Pop()
+	var index = popInt(program, "set-nth")
+	var list = slices.Clone(popList(program, "set-nth"))
+	list[listIndex(list, index, "set-nth", false)] = value
+	pushValue(program, list)
+}
+
+func appendElement(program *ForthProgram) {
+	requireDepth(program, 2, "append")
+	var value, _ = program.forthStack.Pop()
+	var list = popList(program, "append")
+	pushValue(program, append(slices.Clip(list), value))
+}
+
+func sliceList(program *ForthProgram) {
+	var end = popInt(program, "slice")
+	var start = popInt(program, "slice")
+	var list = popList(program, "slice")
+	var startIndex, endIndex = listIndex(list, start, "slice", true), listIndex(list, end, "slice", true)
+	if startIndex > endIndex {
+		variant.Raise(variant.ErrInvalidNumericArgument, "'slice' start %d is after end %d", start, end)
+	}
+
+	pushValue(program, slices.Clone(list[startIndex:endIndex]))
+}
+
+func concat(program *ForthProgram) {
+	var second = popList(program, "concat")
+	var first = popList(program, "concat")
+	pushValue(program, first.Add(second))
+}
+
+func forEach(program *ForthProgram) {
+	var xt = popXt(program, "for-each")
+	var list = popList(program, "for-each")
+	for _, element := range list {
+		pushValue(program, element)
+		ExecuteWord(program, string(xt))
+	}
+}
+
+func mapList(program *ForthProgram) {
+	var xt = popXt(program, "map")
+	var list = popList(program, "map")
+	var result = make(variant.ForthList, len(list))
+	for i, element := range list {
+		result[i] = callXt(program, xt, "map", element)
+	}
+
+	pushValue(program, result)
+}
+
+func filterList(program *ForthProgram) {
+	var xt = popXt(program, "filter")
+	var list = popList(program, "filter")
+	var result = variant.ForthList{}
+	for _, element := range list {
+		if callXt(program, xt, "filter", element).AsBool() {
+			result = append(result, element)
+		}
+	}
+
+	pushValue(program, result)
+}
+
+func reduceList(program *ForthProgram) {
+	var xt = popXt(program, "reduce")
+	requireDepth(program, 2, "reduce")
+	var accumulator, _ = program.forthStack.Pop()
+	var list = popList(program, "reduce")
+	for _, element := range list {
+		accumulator = callXt(program, xt, "reduce", accumulator, element)
+	}
+
+	pushValue(program, accumulator)
+}
+
+func sortList(program *ForthProgram) {
+	var xt = popXt(program, "sort")
+	var list = slices.Clone(popList(program, "sort"))
+	sort.SliceStable(list, func(i int, j int) bool {
+		return callXt(program, xt, "sort", list[i], list[j]).AsBool()
+	})
+
+	pushValue(program, list)
+}
+
+var listFunctions = map[string]func(*ForthProgram){
+	"{":        beginList,
+	"}":        endList,
+	"length":   length,
+	"nth":      nth,
+	"find":     find,
+	"set-nth":  setNth,
+	"append":   appendElement,
+	"slice":    sliceList,
+	"concat":   concat,
+	"for-each": forEach,
+	"map":      mapList,
+	"filter":   filterList,
+	"reduce":   reduceList,
+	"sort":     sortList,
+}
+
+func init() {
+	maps.Copy(builtinFunctions, listFunctions)
+}
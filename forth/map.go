@@ -0,0 +1,91 @@
+package forth
+
+import (
+	"maps"
+
+	"goforth/variant"
+)
+
+func popMap(program *ForthProgram, word string) variant.ForthMap {
+	requireDepth(program, 1, word)
+	var top, _ = program.forthStack.Pop()
+	if forthMap, isMap := top.(variant.ForthMap); isMap {
+		return forthMap
+	}
+
+	variant.Raise(variant.ErrTypeMismatch, "'%s' expects a map (got %v)", word, top)
+	return variant.ForthMap{}
+}
+
+func newMap(program *ForthProgram) {
+	pushValue(program, variant.NewForthMap())
+}
+
+func mapPut(program *ForthProgram) {
+	requireDepth(program, 3, "put")
+	var value, _ = program.forthStack.Pop()
+	var key, _ = program.forthStack.Pop()
+	pushValue(program, popMap(program, "put").Put(key, value))
+}
+
+func mapGet(program *ForthProgram) {
+	requireDepth(program, 3, "get")
+	var defaultValue, _ = program.forthStack.Pop()
+	var key, _ = program.forthStack.Pop()
+	if value, found := popMap(program, "get").Get(key); found {
+		pushValue(program, value)
+	} else {
+		pushValue(program, defaultValue)
+	}
+}
+
+func mapLookup(program *ForthProgram) {
+	requireDepth(program, 2, "lookup")
+	var key, _ = program.forthStack.Pop()
+	if value, found := popMap(program, "lookup").Get(key); found {
+		pushValue(program, value)
+	} else {
+		pushValue(program, variant.ForthNil{})
+	}
+}
+
+func mapHas(program *ForthProgram) {
+	requireDepth(program, 2, "has")
+	var key, _ = program.forthStack.Pop()
+	var _, found = popMap(program, "has").Get(key)
+	pushValue(program, variant.ForthBool(found))
+}
+
+func mapDelete(program *ForthProgram) {
+	requireDepth(program, 2, "delete")
+	var key, _ = program.forthStack.Pop()
+	pushValue(program, popMap(program, "delete").Delete(key))
+}
+
+func mapKeys(program *ForthProgram) {
+	pushValue(program, variant.ForthList(popMap(program, "keys").Keys()))
+}
+
+func mapValues(program *ForthProgram) {
+	pushValue(program, variant.ForthList(popMap(program, "values").Values()))
+}
+
+func mapSize(program *ForthProgram) {
+	pushValue(program, variant.ForthInt(popMap(program, "size").Len()))
+}
+
+var mapFunctions = map[string]func(*ForthProgram){
+	"new-map": newMap,
+	"put":     mapPut,
+	"get":     mapGet,
+	"lookup":  mapLookup,
+	"has":     mapHas,
+	"delete":  mapDelete,
+	"keys":    mapKeys,
+	"values":  mapValues,
+	"size":    mapSize,
+}
+
+func init() {
+	maps.Copy(builtinFunctions, mapFunctions)
+}
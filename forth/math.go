@@ -0,0 +1,455 @@
+package forth
+
+import (
+	"maps"
+	"math"
+	"math/big"
+
+	"goforth/variant"
+)
+
+func popNumber(program *ForthProgram, word string) variant.Variant {
+	requireDepth(program, 1, word)
+	var top, _ = program.forthStack.Pop()
+	switch top.(type) {
+	case variant.ForthInt, variant.ForthBigInt, variant.ForthRational, variant.ForthDecimal, variant.ForthFloat, variant.ForthComplex:
+		return top
+	}
+
+	if isRegisteredType(program, top) {
+		return top
+	}
+
+	variant.Raise(variant.ErrTypeMismatch, "Invalid '%s' operand (%v)", word, top)
+	return nil
+}
+
+// applyOperator applies a binary operator the way the word for it would, so
+// registered types get their own operators.
+func applyOperator(program *ForthProgram, word string, lhs variant.Variant, rhs variant.Variant) variant.Variant {
+	var function, _ = binaryOperator(program, word)
+	return function(lhs, rhs)
+}
+
+func popIntegerOrFloat(program *ForthProgram, word string) variant.Variant {
+	requireDepth(program, 1, word)
+	var top, _ = program.forthStack.Pop()
+	switch top.(type) {
+	case variant.ForthInt, variant.ForthBigInt, variant.ForthFloat:
+		return top
+	default:
+		variant.Raise(variant.ErrTypeMismatch, "Invalid '%s' operand (%v)", word, top)
+		return nil
+	}
+}
+
+func popInt(program *ForthProgram, word string) variant.ForthInt {
+	requireDepth(program, 1, word)
+	var top, _ = program.forthStack.Pop()
+	if topCast, isInt := top.(variant.ForthInt); isInt {
+		return topCast
+	}
+
+	variant.Raise(variant.ErrTypeMismatch, "Invalid '%s' operand (%v)", word, top)
+	return 0
+}
+
+func anyFloat(values ...variant.Variant) bool {
+	for _, value := range values {
+		if _, isFloat := value.(variant.ForthFloat); isFloat {
+			return true
+		}
+	}
+
+	return false
+}
+
+func asFloat64(value variant.Variant) float64 {
+	switch valueCast := value.(type) {
+	case variant.ForthInt:
+		return float64(valueCast)
+	case variant.ForthBigInt:
+		return float64(valueCast.Float())
+	case variant.ForthFloat:
+		return float64(valueCast)
+	default:
+		return 0
+	}
+}
+
+func anyBigInt(values ...variant.Variant) bool {
+	for _, value := range values {
+		if _, isBigInt := value.(variant.ForthBigInt); isBigInt {
+			return true
+		}
+	}
+
+	return false
+}
+
+func asBigInt(value variant.Variant) *big.Int {
+	switch valueCast := value.(type) {
+	case variant.ForthInt:
+		return big.NewInt(int64(valueCast))
+	case variant.ForthBigInt:
+		return valueCast.BigInt()
+	default:
+		return new(big.Int)
+	}
+}
+
+func integerResult(value *big.Int, allowBigInt bool, word string) variant.Variant {
+	if !value.IsInt64() && !allowBigInt {
+		variant.Raise(variant.ErrResultOutOfRange, "Result of '%s' is out of range (%v)", word, value)
+	}
+
+	return variant.FromBigInt(value)
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////
+
+func absolute(program *ForthProgram) {
+	switch operand := popNumber(program, "abs").(type) {
+	case variant.ForthInt:
+		if operand == math.MinInt64 {
+			if !program.promoteBigInts {
+				variant.Raise(variant.ErrResultOutOfRange, "Result of 'abs' is out of range (%v)", operand)
+			}
+
+			pushValue(program, variant.PromotingSub(variant.ForthInt(0), operand))
+		} else if operand < 0 {
+			pushValue(program, -operand)
+		} else {
+			pushValue(program, operand)
+		}
+	case variant.ForthBigInt:
+		pushValue(program, variant.FromBigInt(new(big.Int).Abs(operand.BigInt())))
+	case variant.ForthRational, variant.ForthDecimal:
+		if operand.Lt(variant.ForthInt(0)).AsBool() {
+			pushValue(program, operand.Mul(variant.ForthInt(-1)))
+		} else {
+			pushValue(program, operand)
+		}
+	case variant.ForthFloat:
+		pushValue(program, variant.ForthFloat(math.Abs(float64(operand))))
+	case variant.ForthComplex:
+		pushValue(program, operand.Abs())
+	default:
+		if negated := applyOperator(program, "*", operand, variant.ForthInt(-1)); negated.Gt(operand).AsBool() {
+			pushValue(program, negated)
+		} else {
+			pushValue(program, operand)
+		}
+	}
+}
+
+func negate(program *ForthProgram) {
+	switch operand := popNumber(program, "negate").(type) {
+	case variant.ForthInt:
+		if operand == math.MinInt64 {
+			if !program.promoteBigInts {
+				variant.Raise(variant.ErrResultOutOfRange, "Result of 'negate' is out of range (%v)", operand)
+			}
+
+			pushValue(program, variant.PromotingSub(variant.ForthInt(0), operand))
+		} else {
+			pushValue(program, -operand)
+		}
+	case variant.ForthBigInt:
+		pushValue(program, variant.FromBigInt(new(big.Int).Neg(operand.BigInt())))
+	case variant.ForthRational, variant.ForthDecimal:
+		pushValue(program, operand.Mul(variant.ForthInt(-1)))
+	case variant.ForthFloat:
+		pushValue(program, -operand)
+	case variant.ForthComplex:
+		pushValue(program, -operand)
+	default:
+		pushValue(program, applyOperator(program, "*", operand, variant.ForthInt(-1)))
+	}
+}
+
+func minimum(program *ForthProgram) {
+	requireDepth(program, 2, "min")
+	var rhs, _ = program.forthStack.Pop()
+	var lhs, _ = program.forthStack.Pop()
+	if rhs.Lt(lhs).AsBool() {
+		pushValue(program, rhs)
+	} else {
+		pushValue(program, lhs)
+	}
+}
+
+func maximum(program *ForthProgram) {
+	requireDepth(program, 2, "max")
+	var rhs, _ = program.forthStack.Pop()
+	var lhs, _ = program.forthStack.Pop()
+	if rhs.Gt(lhs).AsBool() {
+		pushValue(program, rhs)
+	} else {
+		pushValue(program, lhs)
+	}
+}
+
+func increment(program *ForthProgram) {
+	pushValue(program, applyOperator(program, "+", popNumber(program, "1+"), variant.ForthInt(1)))
+}
+
+func decrement(program *ForthProgram) {
+	pushValue(program, applyOperator(program, "-", popNumber(program, "1-"), variant.ForthInt(1)))
+}
+
+func double(program *ForthProgram) {
+	switch operand := popNumber(program, "2*").(type) {
+	case variant.ForthInt:
+		if doubled := operand << 1; doubled>>1 == operand {
+			pushValue(program, doubled)
+		} else if program.promoteBigInts {
+			pushValue(program, variant.FromBigInt(new(big.Int).Lsh(big.NewInt(int64(operand)), 1)))
+		} else if program.checkedArithmetic {
+			variant.Raise(variant.ErrResultOutOfRange, "Integer overflow in '2*' (%v)", operand)
+		} else {
+			pushValue(program, doubled)
+		}
+	case variant.ForthBigInt:
+		pushValue(program, variant.FromBigInt(new(big.Int).Lsh(operand.BigInt(), 1)))
+	case variant.ForthRational, variant.ForthDecimal:
+		pushValue(program, operand.Mul(variant.ForthInt(2)))
+	case variant.ForthFloat:
+		pushValue(program, operand*2)
+	case variant.ForthComplex:
+		pushValue(program, operand*2)
+	default:
+		pushValue(program, applyOperator(program, "*", operand, variant.ForthInt(2)))
+	}
+}
+
+func halve(program *ForthProgram) {
+	switch operand := popNumber(program, "2/").(type) {
+	case variant.ForthInt:
+		pushValue(program, operand>>1)
+	case variant.ForthBigInt:
+		pushValue(program, variant.FromBigInt(new(big.Int).Rsh(operand.BigInt(), 1)))
+	case variant.ForthRational:
+		pushValue(program, operand.Div(variant.ForthInt(2)))
+	case variant.ForthDecimal:
+		pushValue(program, operand.Mul(variant.NewForthDecimal(big.NewInt(5), 1)))
+	case variant.ForthFloat:
+		pushValue(program, operand/2)
+	case variant.ForthComplex:
+		pushValue(program, operand/2)
+	default:
+		pushValue(program, applyOperator(program, "/", operand, variant.ForthInt(2)))
+	}
+}
+
+func popShiftCount(program *ForthProgram, word string) uint {
+	var count = popInt(program, word)
+	if count < 0 {
+		variant.Raise(variant.ErrInvalidNumericArgument, "Invalid '%s' shift count (%v)", word, count)
+	}
+
+	return uint(count)
+}
+
+func shiftLeft(program *ForthProgram) {
+	var count = popShiftCount(program, "lshift")
+	var operand = popInt(program, "lshift")
+	var shifted = variant.ForthInt(uint64(operand) << count)
+	if program.checkedArithmetic && shifted>>count != operand {
+		variant.Raise(variant.ErrResultOutOfRange, "Integer overflow in 'lshift' (%v and %v)", operand, count)
+	}
+
+	pushValue(program, shifted)
+}
+
+func shiftRight(program *ForthProgram) {
+	var count = popShiftCount(program, "rshift")
+	var operand = popInt(program, "rshift")
+	pushValue(program, variant.ForthInt(uint64(operand)>>count))
+}
+
+func divideMod(program *ForthProgram) {
+	var divisor = popIntegerOrFloat(program, "/mod")
+	var dividend = popIntegerOrFloat(program, "/mod")
+	if anyFloat(dividend, divisor) {
+		var quotient = math.Trunc(asFloat64(dividend) / asFloat64(divisor))
+		var remainder = math.Mod(asFloat64(dividend), asFloat64(divisor))
+		pushValue(program, variant.ForthFloat(remainder))
+		pushValue(program, variant.ForthFloat(quotient))
+		return
+	}
+
+	var lhs, rhs = asBigInt(dividend), asBigInt(divisor)
+	if rhs.Sign() == 0 {
+		variant.Raise(variant.ErrDivisionByZero, "Division by zero in '/mod' (%v and %v)", dividend, divisor)
+	}
+
+	var allowBigInt = program.promoteBigInts || anyBigInt(dividend, divisor)
+	var quotient, remainder = new(big.Int).QuoRem(lhs, rhs, new(big.Int))
+	pushQuotientRemainder(program, "/mod", quotient, remainder, allowBigInt)
+}
+
+// pushQuotientRemainder converts both results before pushing either, so an
+// out-of-range quotient leaves nothing behind.
+func pushQuotientRemainder(program *ForthProgram, word string, quotient *big.Int, remainder *big.Int, allowBigInt bool) {
+	var quotientResult = integerResult(quotient, allowBigInt, word)
+	var remainderResult = integerResult(remainder, allowBigInt, word)
+	pushValue(program, remainderResult)
+	pushValue(program, quotientResult)
+}
+
+type scaleOperands struct {
+	product     *big.Int
+	divisor     *big.Int
+	allowBigInt bool
+	floats      []float64
+}
+
+func popScaleOperands(program *ForthProgram, word string) scaleOperands {
+	var c = popIntegerOrFloat(program, word)
+	var b = popIntegerOrFloat(program, word)
+	var a = popIntegerOrFloat(program, word)
+	if anyFloat(a, b, c) {
+		return scaleOperands{floats: []float64{asFloat64(a), asFloat64(b), asFloat64(c)}}
+	}
+
+	var divisor = asBigInt(c)
+	if divisor.Sign() == 0 {
+		variant.Raise(variant.ErrDivisionByZero, "Division by zero in '%s' (%v %v %v)", word, a, b, c)
+	}
+
+	var product = new(big.Int).Mul(asBigInt(a), asBigInt(b))
+	return scaleOperands{product, divisor, program.promoteBigInts || anyBigInt(a, b, c), nil}
+}
+
+func scale(program *ForthProgram) {
+	var operands = popScaleOperands(program, "*/")
+	if operands.floats != nil {
+		pushValue(program, variant.ForthFloat(operands.floats[0]*operands.floats[1]/operands.floats[2]))
+		return
+	}
+
+	var quotient = new(big.Int).Quo(operands.product, operands.divisor)
+	pushValue(program, integerResult(quotient, operands.allowBigInt, "*/"))
+}
+
+func scaleMod(program *ForthProgram) {
+	var operands = popScaleOperands(program, "*/mod")
+	if operands.floats != nil {
+		var floatProduct = operands.floats[0] * operands.floats[1]
+		var quotient = math.Trunc(floatProduct / operands.floats[2])
+		var remainder = math.Mod(floatProduct, operands.floats[2])
+		pushValue(program, variant.ForthFloat(remainder))
+		pushValue(program, variant.ForthFloat(quotient))
+		return
+	}
+
+	var quotient, remainder = new(big.Int).QuoRem(operands.product, operands.divisor, new(big.Int))
+	pushQuotientRemainder(program, "*/mod", quotient, remainder, operands.allowBigInt)
+}
+
+// '/' and '%' truncate toward zero like Go, as does SM/REM (symmetric division).
+// FM/MOD rounds the quotient toward negative infinity, so the remainder takes the divisor's sign.
+func popDivisionOperands(program *ForthProgram, word string) (variant.ForthInt, variant.ForthInt) {
+	var divisor = popInt(program, word)
+	var dividend = popInt(program, word)
+	if divisor == 0 {
+		variant.Raise(variant.ErrDivisionByZero, "Division by zero in '%s' (%v and %v)", word, dividend, divisor)
+	}
+
+	if dividend == math.MinInt64 && divisor == -1 {
+		variant.Raise(variant.ErrResultOutOfRange, "Result of '%s' is out of range (%v and %v)", word, dividend, divisor)
+	}
+
+	return dividend, divisor
+}
+
+func symmetricDivMod(program *ForthProgram) {
+	var dividend, divisor = popDivisionOperands(program, "sm/rem")
+	pushValue(program, dividend%divisor)
+	pushValue(program, dividend/divisor)
+}
+
+func flooredDivMod(program *ForthProgram) {
+	var dividend, divisor = popDivisionOperands(program, "fm/mod")
+	var quotient, remainder = dividend / divisor, dividend % divisor
+	if remainder != 0 && (remainder < 0) != (divisor < 0) {
+		quotient--
+		remainder += divisor
+	}
+
+	pushValue(program, remainder)
+	pushValue(program, quotient)
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////
+
+func floatUnary(word string, operation func(float64) float64) func(*ForthProgram) {
+	return func(program *ForthProgram) {
+		pushFloat(program, variant.ForthFloat(operation(float64(popFloat(program, word)))))
+	}
+}
+
+func floatUnaryPositive(word string, allowZero bool, operation func(float64) float64) func(*ForthProgram) {
+	return func(program *ForthProgram) {
+		var operand = popFloat(program, word)
+		if operand < 0 || (operand == 0 && !allowZero) || math.IsNaN(float64(operand)) {
+			variant.Raise(variant.ErrInvalidFloatArgument, "Invalid '%s' operand (%v)", word, operand)
+		}
+
+		pushFloat(program, variant.ForthFloat(operation(float64(operand))))
+	}
+}
+
+func floatArcTangent2(program *ForthProgram) {
+	var x = popFloat(program, "fatan2")
+	var y = popFloat(program, "fatan2")
+	pushFloat(program, variant.ForthFloat(math.Atan2(float64(y), float64(x))))
+}
+
+func floatPower(program *ForthProgram) {
+	var exponent = popFloat(program, "f**")
+	var base = popFloat(program, "f**")
+	pushFloat(program, variant.ForthFloat(math.Pow(float64(base), float64(exponent))))
+}
+
+func pushPi(program *ForthProgram) {
+	pushFloat(program, math.Pi)
+}
+
+var mathFunctions = map[string]func(*ForthProgram){
+	"abs":    absolute,
+	"negate": negate,
+	"min":    minimum,
+	"max":    maximum,
+	"1+":     increment,
+	"1-":     decrement,
+	"2*":     double,
+	"2/":     halve,
+	"lshift": shiftLeft,
+	"rshift": shiftRight,
+	"/mod":   divideMod,
+	"*/":     scale,
+	"*/mod":  scaleMod,
+	"sm/rem": symmetricDivMod,
+	"fm/mod": flooredDivMod,
+
+	"fabs":   floatUnary("fabs", math.Abs),
+	"fsqrt":  floatUnaryPositive("fsqrt", true, math.Sqrt),
+	"fsin":   floatUnary("fsin", math.Sin),
+	"fcos":   floatUnary("fcos", math.Cos),
+	"ftan":   floatUnary("ftan", math.Tan),
+	"fatan2": floatArcTangent2,
+	"fexp":   floatUnary("fexp", math.Exp),
+	"fln":    floatUnaryPositive("fln", false, math.Log),
+	"flog":   floatUnaryPositive("flog", false, math.Log10),
+	"f**":    floatPower,
+	"floor":  floatUnary("floor", math.Floor),
+	"fround": floatUnary("fround", math.RoundToEven),
+	"ftrunc": floatUnary("ftrunc", math.Trunc),
+	"pi":     pushPi,
+}
+
+func init() {
+	maps.Copy(builtinFunctions, mathFunctions)
+}
@@ -0,0 +1,44 @@
+package forth
+
+import (
+	"bufio"
+	"io"
+)
+
+type ProgramOption func(*ForthProgram)
+
+func WithStdout(writer io.Writer) ProgramOption {
+	return func(program *ForthProgram) {
+		program.stdout = writer
+	}
+}
+
+func WithStderr(writer io.Writer) ProgramOption {
+	return func(program *ForthProgram) {
+		program.stderr = writer
+	}
+}
+
+// WithStdin reuses the reader as-is when it is already buffered, so a host
+// that reads lines from the same *bufio.Reader stays in step with KEY and ACCEPT.
+func WithStdin(reader io.Reader) ProgramOption {
+	return func(program *ForthProgram) {
+		if buffered, isBuffered := reader.(*bufio.Reader); isBuffered {
+			program.stdin = buffered
+		} else {
+			program.stdin = bufio.NewReader(reader)
+		}
+	}
+}
+
+func (program *ForthProgram) Stdout() io.Writer {
+	return program.stdout
+}
+
+func (program *ForthProgram) Stderr() io.Writer {
+	return program.stderr
+}
+
+func (program *ForthProgram) Stdin() *bufio.Reader {
+	return program.stdin
+}
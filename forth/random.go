@@ -0,0 +1,75 @@
+package forth
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+
+	"goforth/variant"
+)
+
+func WithSeed(seed uint64) ProgramOption {
+	return func(program *ForthProgram) {
+		program.randomSource.Seed(seed, 0)
+	}
+}
+
+func newRandomSource() *rand.PCG {
+	return rand.NewPCG(rand.Uint64(), rand.Uint64())
+}
+
+func seed(program *ForthProgram) {
+	program.randomSource.Seed(uint64(popInt(program, "seed")), 0)
+}
+
+func randomRange(program *ForthProgram) {
+	var upper = popInt(program, "rand-range")
+	var lower = popInt(program, "rand-range")
+	if upper <= lower {
+		variant.Raise(variant.ErrInvalidNumericArgument, "'rand-range' needs a non-empty range (%v to %v)", lower, upper)
+	}
+
+	var offset = program.random.Uint64N(uint64(upper) - uint64(lower))
+	pushValue(program, lower+variant.ForthInt(offset))
+}
+
+func randomfRange(program *ForthProgram) {
+	var upper = popFloat(program, "randf-range")
+	var lower = popFloat(program, "randf-range")
+	if !(upper > lower) {
+		variant.Raise(variant.ErrInvalidFloatArgument, "'randf-range' needs a non-empty range (%v to %v)", lower, upper)
+	}
+
+	pushFloat(program, lower+(upper-lower)*variant.ForthFloat(program.random.Float64()))
+}
+
+func shuffle(program *ForthProgram) {
+	var list = slices.Clone(popList(program, "shuffle"))
+	program.random.Shuffle(len(list), func(i int, j int) {
+		list[i], list[j] = list[j], list[i]
+	})
+
+	pushValue(program, list)
+}
+
+func choice(program *ForthProgram) {
+	var list = popList(program, "choice")
+	if len(list) == 0 {
+		variant.Raise(variant.ErrInvalidNumericArgument, "'choice' needs a non-empty list")
+	}
+
+	pushValue(program, list[program.random.IntN(len(list))])
+}
+
+var randomFunctions = map[string]func(*ForthProgram){
+	"seed":        seed,
+	"rand-range":  randomRange,
+	"randf-range": randomfRange,
+	"shuffle":     shuffle,
+	"choice":      choice,
+}
+
+func init() {
+	maps.Copy(builtinFunctions, randomFunctions)
+	requireCapability(randomFunctions, CapabilityRand)
+}
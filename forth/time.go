@@ -0,0 +1,47 @@
+package forth
+
+import (
+	"maps"
+	"time"
+
+	"goforth/variant"
+)
+
+func sleepMilliseconds(program *ForthProgram) {
+	var milliseconds = popInt(program, "ms")
+	var timer = time.NewTimer(time.Duration(milliseconds) * time.Millisecond)
+	defer timer.Stop()
+
+	if program.ctx == nil {
+		<-timer.C
+		return
+	}
+
+	select {
+	case <-timer.C:
+	case <-program.ctx.Done():
+		panic(interruption(program.ctx))
+	}
+}
+
+func timeAndDate(program *ForthProgram) {
+	var now = time.Now()
+	for _, field := range []int{now.Second(), now.Minute(), now.Hour(), now.Day(), int(now.Month()), now.Year()} {
+		pushValue(program, variant.ForthInt(field))
+	}
+}
+
+func microseconds(program *ForthProgram) {
+	pushValue(program, variant.ForthInt(time.Now().UnixMicro()))
+}
+
+var timeFunctions = map[string]func(*ForthProgram){
+	"ms":        sleepMilliseconds,
+	"time&date": timeAndDate,
+	"utime":     microseconds,
+}
+
+func init() {
+	maps.Copy(builtinFunctions, timeFunctions)
+	requireCapability(timeFunctions, CapabilityTime)
+}
@@ -0,0 +1,19 @@
+package forth
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WithTrace writes every word the program executes to writer, followed by
+// the data stack as it stands afterwards.
+func WithTrace(writer io.Writer) ProgramOption {
+	return func(program *ForthProgram) {
+		program.trace = writer
+	}
+}
+
+func traceWord(program *ForthProgram, word string) {
+	fmt.Fprintf(program.trace, "%s %s\n", word, strings.TrimSuffix(formatStack(program), " "))
+}
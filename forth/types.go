@@ -0,0 +1,78 @@
+package forth
+
+import (
+	"fmt"
+	"reflect"
+
+	"goforth/variant"
+)
+
+type VariantType struct {
+	Name      string
+	Prototype variant.Variant
+	Parse     func(word string) (variant.Variant, bool)
+	Operators map[string]func(lhs variant.Variant, rhs variant.Variant) variant.Variant
+}
+
+func (variantType *VariantType) matches(value variant.Variant) bool {
+	return reflect.TypeOf(value) == reflect.TypeOf(variantType.Prototype)
+}
+
+func (program *ForthProgram) RegisterType(variantType VariantType) error {
+	if variantType.Name == "" {
+		return fmt.Errorf("variant type needs a name")
+	} else if variantType.Prototype == nil {
+		return fmt.Errorf("variant type '%s' needs a prototype value", variantType.Name)
+	}
+
+	for operator := range variantType.Operators {
+		if _, found := binaryOperators[operator]; !found {
+			return fmt.Errorf("variant type '%s' overrides unknown operator '%s'", variantType.Name, operator)
+		}
+	}
+
+	for _, registered := range program.variantTypes {
+		if registered.Name == variantType.Name || registered.matches(variantType.Prototype) {
+			return fmt.Errorf("variant type '%s' is already registered", variantType.Name)
+		}
+	}
+
+	program.variantTypes = append(program.variantTypes, variantType)
+	return nil
+}
+
+func isRegisteredType(program *ForthProgram, value variant.Variant) bool {
+	for _, variantType := range program.variantTypes {
+		if variantType.matches(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseRegisteredLiteral(program *ForthProgram, word string) (variant.Variant, bool) {
+	for _, variantType := range program.variantTypes {
+		if variantType.Parse == nil {
+			continue
+		}
+
+		if value, ok := variantType.Parse(word); ok {
+			return value, true
+		}
+	}
+
+	return nil, false
+}
+
+func registeredOperator(program *ForthProgram, operator string, lhs variant.Variant, rhs variant.Variant) (func(variant.Variant, variant.Variant) variant.Variant, bool) {
+	for _, variantType := range program.variantTypes {
+		if variantType.matches(lhs) || variantType.matches(rhs) {
+			if function, found := variantType.Operators[operator]; found {
+				return function, true
+			}
+		}
+	}
+
+	return nil, false
+}
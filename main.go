@@ -2,32 +2,186 @@ package main
 
 import (
 	"bufio"
-	"log"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
 
 	"goforth/forth"
+	"goforth/repl"
+	"goforth/variant"
 )
 
+const (
+	exitOK          = 0
+	exitError       = 1
+	exitUsage       = 2
+	exitInterrupted = 130
+)
+
+// source is a file, run with ExecuteFile so its INCLUDEs resolve next to it,
+// or code from stdin or -e.
+type source struct {
+	name string
+	code string
+	file bool
+}
+
 func main() {
-	var program = forth.NewForthProgram()
-
-	switch len(os.Args) {
-	case 1:
-		var reader = bufio.NewReader(os.Stdin)
-		for {
-			var input, _ = reader.ReadString('\n')
-			forth.ExecuteWordLine(&program, input)
-		}
-	case 2:
-		if infile, err := os.Open(os.Args[1]); err == nil {
-			var scanner = bufio.NewScanner(infile)
-			for scanner.Scan() {
-				forth.ExecuteWordLine(&program, scanner.Text())
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	var flags = flag.NewFlagSet("goforth", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	var sources []source
+	var options []forth.ProgramOption
+	flags.Func("e", "evaluate `code`; may be repeated", func(code string) error {
+		sources = append(sources, source{"-e", code, false})
+		return nil
+	})
+	flags.Func("seed", "seed the random number generator with `n`", func(text string) error {
+		var seed, err = strconv.ParseUint(text, 10, 64)
+		options = append(options, forth.WithSeed(seed))
+		return err
+	})
+	var interactive = flags.Bool("i", false, "start the REPL after running the files and expressions")
+	var trace = flags.Bool("trace", false, "print each word executed, and the stack after it, to stderr")
+	var maxSteps = flags.Int("max-steps", 0, "abort a file or expression after `n` words (0 means no limit)")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: goforth [flags] [file ...]\n\n")
+		fmt.Fprintf(flags.Output(), "Runs files and -e code in the order they are given, reading '-' from stdin,\n")
+		fmt.Fprintf(flags.Output(), "then starts the REPL if there was nothing to run. Flags may follow files;\n")
+		fmt.Fprintf(flags.Output(), "everything after '--' is a file. When the first file starts with '#!' it\n")
+		fmt.Fprintf(flags.Output(), "is run as a script, and the arguments after it are left for ARGS.\n\n")
+		flags.PrintDefaults()
+	}
+
+	var reader = bufio.NewReader(stdin)
+	var scriptArgs []string
+	var sawFile = false
+	for {
+		if err := flags.Parse(args); errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		} else if err != nil {
+			return exitUsage
+		}
+
+		var rest = flags.Args()
+		if len(rest) == 0 {
+			break
+		}
+
+		var names, remaining = rest[:1], rest[1:]
+		if len(rest) < len(args) && args[len(args)-len(rest)-1] == "--" {
+			names, remaining = rest, nil
+		}
+
+		for i, name := range names {
+			var code, err = readSource(name, reader)
+			if err != nil {
+				fmt.Fprintf(stderr, "Error: Can't open file %s: %v\n", name, err)
+				return exitError
+			}
+
+			sources = append(sources, source{name, code, name != "-"})
+			if !sawFile && strings.HasPrefix(code, "#!") {
+				scriptArgs, remaining = rest[i+1:], nil
+				break
 			}
+
+			sawFile = true
+		}
+
+		if len(remaining) == 0 {
+			break
+		}
+
+		args = remaining
+	}
+
+	options = append(options, forth.WithStdin(reader), forth.WithStdout(stdout), forth.WithStderr(stderr), forth.WithArgs(scriptArgs))
+	if *trace {
+		options = append(options, forth.WithTrace(stderr))
+	}
+
+	if *maxSteps > 0 {
+		options = append(options, forth.WithMaxSteps(*maxSteps))
+	}
+
+	var program = forth.NewForthProgram(options...)
+	if status, finished := runSources(program, sources); finished {
+		return status
+	}
+
+	if *interactive || len(sources) == 0 {
+		var replOptions = []repl.Option{repl.WithHistoryFile(repl.DefaultHistoryPath())}
+		if terminal, isFile := stdin.(*os.File); isFile {
+			replOptions = append(replOptions, repl.WithTerminal(terminal))
+		}
+
+		if err := repl.New(program, replOptions...).Run(); err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", err)
+			return exitError
+		}
+	}
+
+	return exitOK
+}
+
+func readSource(name string, stdin io.Reader) (string, error) {
+	var contents []byte
+	var err error
+	if name == "-" {
+		contents, err = io.ReadAll(stdin)
+	} else {
+		contents, err = os.ReadFile(name)
+	}
+
+	return string(contents), err
+}
+
+// runSources reports finished when the program should exit with status
+// rather than carry on, because of an error or BYE.
+func runSources(program *forth.ForthProgram, sources []source) (status int, finished bool) {
+	var ctx, stop = signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	for _, src := range sources {
+		var err error
+		if src.file {
+			err = program.ExecuteFile(ctx, src.name)
+		} else if code, isScript := strings.CutPrefix(src.code, "#!"); isScript {
+			var _, rest, _ = strings.Cut(code, "\n")
+			err = program.ExecuteContext(ctx, "\n"+rest)
+		} else {
+			err = program.ExecuteContext(ctx, src.code)
+		}
+
+		var forthError *variant.ForthError
+		if err == nil {
+			continue
+		} else if errors.As(err, &forthError) && forthError.Code == variant.ErrBye {
+			return exitOK, true
+		}
+
+		if src.file {
+			fmt.Fprintf(program.Stderr(), "Error: %v\n", err)
 		} else {
-			log.Fatalf("Error: Can't open file %s: %v", os.Args[1], err)
+			fmt.Fprintf(program.Stderr(), "Error: %s: %v\n", src.name, err)
+		}
+
+		if errors.As(err, &forthError) && forthError.Code == variant.ErrUserInterrupt {
+			return exitInterrupted, true
 		}
-	default:
-		log.Fatalf("Error: Invalid argument count to go-forth (%d)", len(os.Args))
+
+		return exitError, true
 	}
+
+	return exitOK, false
 }
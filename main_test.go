@@ -0,0 +1,98 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func runCLI(t *testing.T, stdin string, args ...string) (status int, stdout string, stderr string) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	var output, errorOutput strings.Builder
+	status = run(args, strings.NewReader(stdin), &output, &errorOutput)
+	return status, output.String(), errorOutput.String()
+}
+
+func writeScript(t *testing.T, name string, contents string) string {
+	t.Helper()
+	var path = filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(contents), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func expectRun(t *testing.T, stdin string, args []string, expectedStatus int, expectedStdout string) string {
+	t.Helper()
+	var status, stdout, stderr = runCLI(t, stdin, args...)
+	if status != expectedStatus || stdout != expectedStdout {
+		t.Fatalf("Running %q: expected status %d and output %q, got %d and %q (stderr %q)", args, expectedStatus, expectedStdout, status, stdout, stderr)
+	}
+
+	return stderr
+}
+
+func TestSourcesRunInCommandLineOrder(t *testing.T) {
+	var first = writeScript(t, "first.fth", "2 .")
+	var second = writeScript(t, "second.fth", "4 .")
+	expectRun(t, "", []string{"-e", "1 .", first, "-e", "3 .", second, "-e", "5 ."}, exitOK, "12345")
+	expectRun(t, "", []string{first, "-e", ".s"}, exitOK, "2<0> ")
+	expectRun(t, "", []string{"--", first, second}, exitOK, "24")
+}
+
+func TestStdinSource(t *testing.T) {
+	expectRun(t, "6 7 * .", []string{"-"}, exitOK, "42")
+	expectRun(t, "#!/usr/bin/env goforth\n1 .", []string{"-e", "0 .", "-"}, exitOK, "01")
+}
+
+func TestShebangScript(t *testing.T) {
+	var script = writeScript(t, "script.fth", "#!/usr/bin/env goforth\nargs .")
+	expectRun(t, "", []string{script, "-e", "not a file"}, exitOK, "{ -e not a file }")
+}
+
+func TestExitCodes(t *testing.T) {
+	var unterminated = writeScript(t, "open.fth", ": x")
+	var tests = []struct {
+		args   []string
+		status int
+		stderr string
+	}{
+		{[]string{"-e", "1 2 +"}, exitOK, ""},
+		{[]string{"-e", "bogus"}, exitError, "Error: -e: Unrecognized word 'bogus'\n"},
+		{[]string{filepath.Join(t.TempDir(), "missing.fth")}, exitError, "Error: Can't open file"},
+		{[]string{"-e", ".s", unterminated}, exitError, "Error: Unterminated definition of 'x'"},
+		{[]string{"-e", "bye", "-e", "bogus"}, exitOK, ""},
+		{[]string{"--max-steps", "100", "-e", "begin again"}, exitError, "Error: -e: Step limit of 100 exceeded\n"},
+		{[]string{"--no-such-flag"}, exitUsage, "flag provided but not defined"},
+		{[]string{"--seed", "x"}, exitUsage, "invalid value"},
+		{[]string{"-h"}, exitOK, "Usage: goforth"},
+	}
+
+	for _, test := range tests {
+		var status, _, stderr = runCLI(t, "", test.args...)
+		if status != test.status || !strings.HasPrefix(stderr, test.stderr) {
+			t.Fatalf("Running %q: expected status %d and stderr %q, got %d and %q", test.args, test.status, test.stderr, status, stderr)
+		}
+	}
+}
+
+func TestSeedAndTrace(t *testing.T) {
+	var _, first, _ = runCLI(t, "", "--seed", "5", "-e", "rand .")
+	var _, second, _ = runCLI(t, "", "--seed", "5", "-e", "rand .")
+	if first == "" || first != second {
+		t.Fatalf("Expected the same output for the same seed, got %q and %q", first, second)
+	}
+
+	if stderr := expectRun(t, "", []string{"--trace", "-e", "1 dup"}, exitOK, ""); stderr != "1 <1> 1\ndup <2> 1 1\n" {
+		t.Fatalf("Expected a trace on stderr, got %q", stderr)
+	}
+}
+
+func TestInteractiveAfterSources(t *testing.T) {
+	expectRun(t, "2 * .\n", []string{"-e", "21", "-i"}, exitOK, "42 ok\n")
+	expectRun(t, "1 .\n", nil, exitOK, "1 ok\n")
+	expectRun(t, "1 .\n", []string{"-e", "2 ."}, exitOK, "2")
+}
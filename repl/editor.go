@@ -0,0 +1,304 @@
+package repl
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const maxHistory = 1000
+
+var ErrInterrupted = errors.New("interrupted")
+
+const (
+	keyCtrlA     = 1
+	keyCtrlB     = 2
+	keyCtrlC     = 3
+	keyCtrlD     = 4
+	keyCtrlE     = 5
+	keyCtrlF     = 6
+	keyBackspace = 8
+	keyTab       = 9
+	keyLineFeed  = 10
+	keyCtrlK     = 11
+	keyEnter     = 13
+	keyCtrlN     = 14
+	keyCtrlP     = 16
+	keyCtrlU     = 21
+	keyCtrlW     = 23
+	keyEscape    = 27
+	keyDelete    = 127
+)
+
+// Editor reads one line at a time from a terminal in raw mode, handling
+// cursor movement, history and completion itself. It writes VT100 escape
+// sequences to its output, so both ends should be the same terminal.
+type Editor struct {
+	input    *bufio.Reader
+	output   io.Writer
+	complete func(prefix string) []string
+
+	history      []string
+	historyIndex int
+	pending      []rune
+
+	prompt string
+	line   []rune
+	cursor int
+}
+
+func NewEditor(input *bufio.Reader, output io.Writer, complete func(prefix string) []string) *Editor {
+	return &Editor{input: input, output: output, complete: complete}
+}
+
+func (editor *Editor) History() []string {
+	return editor.history
+}
+
+func (editor *Editor) AddHistory(line string) bool {
+	if strings.TrimSpace(line) == "" || (len(editor.history) > 0 && editor.history[len(editor.history)-1] == line) {
+		return false
+	}
+
+	editor.history = append(editor.history, line)
+	if len(editor.history) > maxHistory {
+		editor.history = editor.history[len(editor.history)-maxHistory:]
+	}
+
+	return true
+}
+
+// ReadLine returns ErrInterrupted when the user presses Ctrl-C and io.EOF
+// when they press Ctrl-D on an empty line.
+func (editor *Editor) ReadLine(prompt string) (string, error) {
+	editor.prompt, editor.line, editor.cursor = prompt, nil, 0
+	editor.historyIndex, editor.pending = len(editor.history), nil
+	editor.refresh()
+
+	for {
+		var key, _, err = editor.input.ReadRune()
+		if err != nil {
+			if err == io.EOF && len(editor.line) > 0 {
+				return string(editor.line), nil
+			}
+
+			return "", err
+		}
+
+		switch key {
+		case keyEnter, keyLineFeed:
+			editor.cursor = len(editor.line)
+			editor.refresh()
+			return string(editor.line), nil
+		case keyCtrlC:
+			fmt.Fprint(editor.output, "^C\n")
+			return "", ErrInterrupted
+		case keyCtrlD:
+			if len(editor.line) == 0 {
+				return "", io.EOF
+			}
+
+			editor.deleteAt(editor.cursor)
+		case keyBackspace, keyDelete:
+			if editor.cursor > 0 {
+				editor.cursor--
+				editor.deleteAt(editor.cursor)
+			}
+		case keyCtrlA:
+			editor.cursor = 0
+		case keyCtrlE:
+			editor.cursor = len(editor.line)
+		case keyCtrlB:
+			editor.cursor = max(editor.cursor-1, 0)
+		case keyCtrlF:
+			editor.cursor = min(editor.cursor+1, len(editor.line))
+		case keyCtrlK:
+			editor.line = editor.line[:editor.cursor]
+		case keyCtrlU:
+			editor.line = editor.line[editor.cursor:]
+			editor.cursor = 0
+		case keyCtrlW:
+			editor.deleteWordBack()
+		case keyCtrlP:
+			editor.previousHistory()
+		case keyCtrlN:
+			editor.nextHistory()
+		case keyTab:
+			editor.completeWord()
+		case keyEscape:
+			editor.escapeSequence()
+		default:
+			if key >= ' ' {
+				editor.insert([]rune{key})
+			}
+		}
+
+		editor.refresh()
+	}
+}
+
+func (editor *Editor) refresh() {
+	var back = ""
+	if editor.cursor < len(editor.line) {
+		back = fmt.Sprintf("\x1b[%dD", len(editor.line)-editor.cursor)
+	}
+
+	fmt.Fprintf(editor.output, "\r%s%s\x1b[K%s", editor.prompt, string(editor.line), back)
+}
+
+func (editor *Editor) insert(runes []rune) {
+	var line = make([]rune, 0, len(editor.line)+len(runes))
+	line = append(line, editor.line[:editor.cursor]...)
+	line = append(line, runes...)
+	editor.line = append(line, editor.line[editor.cursor:]...)
+	editor.cursor += len(runes)
+}
+
+func (editor *Editor) deleteAt(position int) {
+	if position < len(editor.line) {
+		editor.line = append(editor.line[:position], editor.line[position+1:]...)
+	}
+}
+
+func (editor *Editor) deleteWordBack() {
+	var start = editor.cursor
+	for start > 0 && editor.line[start-1] == ' ' {
+		start--
+	}
+
+	for start > 0 && editor.line[start-1] != ' ' {
+		start--
+	}
+
+	editor.line = append(editor.line[:start], editor.line[editor.cursor:]...)
+	editor.cursor = start
+}
+
+func (editor *Editor) showHistory(index int) {
+	if index == len(editor.history) {
+		editor.line = editor.pending
+	} else {
+		editor.line = []rune(editor.history[index])
+	}
+
+	editor.historyIndex, editor.cursor = index, len(editor.line)
+}
+
+func (editor *Editor) previousHistory() {
+	if editor.historyIndex > 0 {
+		if editor.historyIndex == len(editor.history) {
+			editor.pending = editor.line
+		}
+
+		editor.showHistory(editor.historyIndex - 1)
+	}
+}
+
+func (editor *Editor) nextHistory() {
+	if editor.historyIndex < len(editor.history) {
+		editor.showHistory(editor.historyIndex + 1)
+	}
+}
+
+// completeWord completes the word before the cursor: a single candidate
+// replaces it in full, several are narrowed to their common prefix and listed
+// when that adds nothing. The word is replaced rather than extended because
+// candidates may differ from it in case.
+func (editor *Editor) completeWord() {
+	if editor.complete == nil {
+		return
+	}
+
+	var start = editor.cursor
+	for start > 0 && editor.line[start-1] != ' ' {
+		start--
+	}
+
+	var prefix = editor.line[start:editor.cursor]
+	var candidates = editor.complete(string(prefix))
+	switch len(candidates) {
+	case 0:
+		fmt.Fprint(editor.output, "\a")
+	case 1:
+		editor.replaceWord(start, candidates[0]+" ")
+	default:
+		var common = commonPrefix(candidates)
+		if len([]rune(common)) > len(prefix) {
+			editor.replaceWord(start, common)
+		} else {
+			fmt.Fprintf(editor.output, "\n%s\n", strings.Join(candidates, "  "))
+		}
+	}
+}
+
+func (editor *Editor) replaceWord(start int, word string) {
+	editor.line = append(editor.line[:start:start], editor.line[editor.cursor:]...)
+	editor.cursor = start
+	editor.insert([]rune(word))
+}
+
+func commonPrefix(words []string) string {
+	var common = []rune(words[0])
+	for _, word := range words[1:] {
+		var length = 0
+		for _, char := range word {
+			if length == len(common) || !strings.EqualFold(string(char), string(common[length])) {
+				break
+			}
+
+			length++
+		}
+
+		common = common[:length]
+	}
+
+	return string(common)
+}
+
+// escapeSequence handles the CSI and SS3 sequences terminals send for the
+// arrow, home, end and delete keys, ignoring any others.
+func (editor *Editor) escapeSequence() {
+	var introducer, _, err = editor.input.ReadRune()
+	if err != nil || (introducer != '[' && introducer != 'O') {
+		return
+	}
+
+	var parameters strings.Builder
+	for {
+		var key, _, err = editor.input.ReadRune()
+		if err != nil {
+			return
+		} else if key < 0x40 || key > 0x7e {
+			parameters.WriteRune(key)
+			continue
+		}
+
+		switch key {
+		case 'A':
+			editor.previousHistory()
+		case 'B':
+			editor.nextHistory()
+		case 'C':
+			editor.cursor = min(editor.cursor+1, len(editor.line))
+		case 'D':
+			editor.cursor = max(editor.cursor-1, 0)
+		case 'H':
+			editor.cursor = 0
+		case 'F':
+			editor.cursor = len(editor.line)
+		case '~':
+			switch parameters.String() {
+			case "1", "7":
+				editor.cursor = 0
+			case "4", "8":
+				editor.cursor = len(editor.line)
+			case "3":
+				editor.deleteAt(editor.cursor)
+			}
+		}
+
+		return
+	}
+}
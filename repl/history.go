@@ -0,0 +1,47 @@
+package repl
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultHistoryPath is ~/.goforth_history, or "" when there is no home
+// directory to keep it in.
+func DefaultHistoryPath() string {
+	var home, err = os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".goforth_history")
+}
+
+func loadHistory(editor *Editor, path string) {
+	if path == "" {
+		return
+	}
+
+	var contents, err = os.ReadFile(path)
+	if err != nil {
+		return
+	}
+
+	for _, line := range strings.Split(string(contents), "\n") {
+		editor.AddHistory(strings.TrimSuffix(line, "\r"))
+	}
+}
+
+func appendHistory(path string, line string) {
+	if path == "" {
+		return
+	}
+
+	var file, err = os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return
+	}
+
+	defer file.Close()
+	file.WriteString(line + "\n")
+}
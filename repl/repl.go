@@ -0,0 +1,176 @@
+package repl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"goforth/forth"
+	"goforth/variant"
+)
+
+type REPL struct {
+	program     *forth.ForthProgram
+	terminal    *os.File
+	historyPath string
+	interactive bool
+	interrupts  chan os.Signal
+}
+
+type Option func(*REPL)
+
+// WithTerminal names the terminal behind the program's stdin and stdout.
+// Lines are only edited in raw mode when it really is a terminal; otherwise
+// they are read as plain text.
+func WithTerminal(file *os.File) Option {
+	return func(repl *REPL) {
+		repl.terminal = file
+	}
+}
+
+func WithHistoryFile(path string) Option {
+	return func(repl *REPL) {
+		repl.historyPath = path
+	}
+}
+
+// WithInterrupts takes interrupts from signals instead of listening for
+// SIGINT, for hosts that handle signals themselves.
+func WithInterrupts(signals chan os.Signal) Option {
+	return func(repl *REPL) {
+		repl.interrupts = signals
+	}
+}
+
+func New(program *forth.ForthProgram, options ...Option) *REPL {
+	var repl = &REPL{program: program}
+	for _, option := range options {
+		option(repl)
+	}
+
+	repl.interactive = repl.terminal != nil && isTerminal(repl.terminal)
+	return repl
+}
+
+// Run reads and executes lines until EOF or BYE. Errors are reported and the
+// loop carries on; Ctrl-C abandons the line being typed or interrupts the
+// line being executed.
+func (repl *REPL) Run() error {
+	var editor = NewEditor(repl.program.Stdin(), repl.program.Stdout(), repl.Complete)
+	loadHistory(editor, repl.historyPath)
+
+	var interrupts = repl.interrupts
+	if interrupts == nil {
+		interrupts = make(chan os.Signal, 1)
+		signal.Notify(interrupts, os.Interrupt)
+		defer signal.Stop(interrupts)
+	}
+
+	for {
+		var line, err = repl.readLine(editor)
+		if errors.Is(err, ErrInterrupted) {
+			continue
+		} else if errors.Is(err, io.EOF) {
+			if repl.interactive {
+				fmt.Fprintln(repl.program.Stdout())
+			}
+
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if editor.AddHistory(line) {
+			appendHistory(repl.historyPath, line)
+		}
+
+		if repl.interactive {
+			fmt.Fprint(repl.program.Stdout(), " ")
+		}
+
+		if err := repl.execute(line, interrupts); err != nil {
+			var forthError *variant.ForthError
+			if errors.As(err, &forthError) && forthError.Code == variant.ErrBye {
+				return nil
+			}
+
+			if repl.interactive {
+				fmt.Fprintln(repl.program.Stdout())
+			}
+
+			fmt.Fprintf(repl.program.Stderr(), "Error: %v\n", err)
+		} else {
+			fmt.Fprintf(repl.program.Stdout(), " %s\n", repl.status())
+		}
+	}
+}
+
+func (repl *REPL) readLine(editor *Editor) (string, error) {
+	if !repl.interactive {
+		var line, err = repl.program.Stdin().ReadString('\n')
+		if err == io.EOF && line != "" {
+			err = nil
+		}
+
+		return strings.TrimRight(line, "\r\n"), err
+	}
+
+	var restore, err = makeRaw(repl.terminal)
+	if err != nil {
+		return "", err
+	}
+
+	defer restore()
+	return editor.ReadLine("")
+}
+
+func (repl *REPL) execute(line string, interrupts chan os.Signal) error {
+	var ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	select {
+	case <-interrupts:
+	default:
+	}
+
+	go func() {
+		select {
+		case <-interrupts:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return repl.program.ExecuteLineContext(ctx, line)
+}
+
+// status is what follows each line, in the style of a traditional Forth:
+// "ok" with the stack depth when it isn't empty, or "compiled" while a
+// definition is still open.
+func (repl *REPL) status() string {
+	if repl.program.IsCompiling() {
+		return "compiled"
+	} else if depth := repl.program.Depth(); depth > 0 {
+		return fmt.Sprintf("ok %d", depth)
+	}
+
+	return "ok"
+}
+
+// Complete lists the words that start with prefix, ignoring case as the
+// interpreter does for builtins and host words.
+func (repl *REPL) Complete(prefix string) []string {
+	var candidates []string
+	var prefixLower = strings.ToLower(prefix)
+	for _, word := range repl.program.Words() {
+		if strings.HasPrefix(strings.ToLower(word), prefixLower) {
+			candidates = append(candidates, word)
+		}
+	}
+
+	return candidates
+}
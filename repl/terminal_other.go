@@ -0,0 +1,16 @@
+//go:build !(linux || darwin || dragonfly || freebsd || netbsd || openbsd)
+
+package repl
+
+import (
+	"errors"
+	"os"
+)
+
+func isTerminal(file *os.File) bool {
+	return false
+}
+
+func makeRaw(file *os.File) (restore func(), err error) {
+	return nil, errors.New("raw terminal mode is not supported on this platform")
+}
@@ -0,0 +1,55 @@
+//go:build linux || darwin || dragonfly || freebsd || netbsd || openbsd
+
+package repl
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+func getTermios(file *os.File) (*syscall.Termios, error) {
+	var termios syscall.Termios
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, file.Fd(), ioctlGetTermios, uintptr(unsafe.Pointer(&termios))); errno != 0 {
+		return nil, errno
+	}
+
+	return &termios, nil
+}
+
+func setTermios(file *os.File, termios *syscall.Termios) error {
+	if _, _, errno := syscall.Syscall(syscall.SYS_IOCTL, file.Fd(), ioctlSetTermios, uintptr(unsafe.Pointer(termios))); errno != 0 {
+		return errno
+	}
+
+	return nil
+}
+
+func isTerminal(file *os.File) bool {
+	var _, err = getTermios(file)
+	return err == nil
+}
+
+// makeRaw turns off echo, line buffering and signal keys so the editor sees
+// every keystroke, including Ctrl-C. Output processing stays on, so "\n"
+// still moves to the start of the next line.
+func makeRaw(file *os.File) (restore func(), err error) {
+	var original, getErr = getTermios(file)
+	if getErr != nil {
+		return nil, getErr
+	}
+
+	var raw = *original
+	raw.Iflag &^= syscall.BRKINT | syscall.ICRNL | syscall.INPCK | syscall.ISTRIP | syscall.IXON
+	raw.Lflag &^= syscall.ECHO | syscall.ICANON | syscall.ISIG | syscall.IEXTEN
+	raw.Cflag |= syscall.CS8
+	raw.Cc[syscall.VMIN] = 1
+	raw.Cc[syscall.VTIME] = 0
+	if err := setTermios(file, &raw); err != nil {
+		return nil, err
+	}
+
+	return func() {
+		setTermios(file, original)
+	}, nil
+}
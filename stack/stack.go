@@ -1,101 +1,145 @@
 package stack
 
-type node[T any] struct {
-	element  T
-	previous *node[T]
-}
+import "slices"
 
 type Stack[T any] struct {
-	top  *node[T]
-	size int
+	elements []T
+	maxDepth int
+}
+
+func NewStack[T any](maxDepth int) Stack[T] {
+	return Stack[T]{maxDepth: maxDepth}
+}
+
+func (stack *Stack[T]) SetMaxDepth(maxDepth int) {
+	stack.maxDepth = maxDepth
+}
+
+func (stack *Stack[T]) MaxDepth() int {
+	return stack.maxDepth
+}
+
+func (stack *Stack[T]) isFull() bool {
+	return stack.maxDepth > 0 && len(stack.elements) >= stack.maxDepth
 }
 
-func (stack *Stack[T]) Push(element T) {
-	var newNode = new(node[T])
-	newNode.element = element
-	newNode.previous = stack.top
-	stack.top = newNode
+func (stack *Stack[T]) Push(element T) bool {
+	if stack.isFull() {
+		return false
+	}
 
-	stack.size++
+	stack.elements = append(stack.elements, element)
+	return true
 }
 
 func (stack *Stack[T]) Size() int {
-	return stack.size
+	return len(stack.elements)
 }
 
 func (stack *Stack[T]) IsEmpty() bool {
-	return stack.size == 0
+	return len(stack.elements) == 0
 }
 
 func (stack *Stack[T]) Top() *T {
-	if stack.top != nil {
-		return &stack.top.element
-	} else {
-		return nil
-	}
+	return stack.Peek(0)
 }
 
 func (stack *Stack[T]) Peek(indexFromTop int) *T {
-	var currentNode = stack.top
-	for i := 0; i < indexFromTop; i++ {
-		currentNode = currentNode.previous
+	if indexFromTop < 0 || indexFromTop >= len(stack.elements) {
+		return nil
 	}
 
-	return &currentNode.element
+	return &stack.elements[len(stack.elements)-1-indexFromTop]
 }
 
 func (stack *Stack[T]) Second() *T {
-	if stack.top != nil && stack.top.previous != nil {
-		return &stack.top.previous.element
-	} else {
-		return nil
-	}
+	return stack.Peek(1)
 }
 
-func (stack *Stack[T]) Pop() {
-	if stack.top != nil {
-		stack.top = stack.top.previous
-		stack.size--
+func (stack *Stack[T]) Pop() (T, bool) {
+	var popped T
+	if len(stack.elements) == 0 {
+		return popped, false
 	}
+
+	var last = len(stack.elements) - 1
+	popped = stack.elements[last]
+
+	var zero T
+	stack.elements[last] = zero
+	stack.elements = stack.elements[:last]
+	return popped, true
+}
+
+func (stack *Stack[T]) Clone() Stack[T] {
+	return Stack[T]{slices.Clone(stack.elements), stack.maxDepth}
 }
 
 func (stack *Stack[T]) Clear() {
-	stack.top = nil
-	stack.size = 0
+	clear(stack.elements)
+	stack.elements = stack.elements[:0]
 }
 
 func (stack *Stack[T]) Array() []T {
-	var result = make([]T, stack.size)
-	var index = 0
-	for stackNode := stack.top; stackNode != nil; stackNode = stackNode.previous {
-		result[index] = stackNode.element
-		index++
+	var result = make([]T, len(stack.elements))
+	for i, element := range stack.elements {
+		result[len(result)-1-i] = element
 	}
 
 	return result
 }
 
+func (stack *Stack[T]) Insert(indexFromTop int, element T) bool {
+	if indexFromTop < 0 || indexFromTop > len(stack.elements) || stack.isFull() {
+		return false
+	}
+
+	var position = len(stack.elements) - indexFromTop
+	var zero T
+	stack.elements = append(stack.elements, zero)
+	copy(stack.elements[position+1:], stack.elements[position:])
+	stack.elements[position] = element
+	return true
+}
+
+func (stack *Stack[T]) Remove(indexFromTop int) (T, bool) {
+	var removed T
+	if indexFromTop < 0 || indexFromTop >= len(stack.elements) {
+		return removed, false
+	}
+
+	var position = len(stack.elements) - 1 - indexFromTop
+	removed = stack.elements[position]
+	copy(stack.elements[position:], stack.elements[position+1:])
+
+	var last = len(stack.elements) - 1
+	var zero T
+	stack.elements[last] = zero
+	stack.elements = stack.elements[:last]
+	return removed, true
+}
+
+func (stack *Stack[T]) Roll(indexFromTop int) bool {
+	if indexFromTop < 0 || indexFromTop >= len(stack.elements) {
+		return false
+	}
+
+	var position = len(stack.elements) - 1 - indexFromTop
+	var element = stack.elements[position]
+	copy(stack.elements[position:], stack.elements[position+1:])
+	stack.elements[len(stack.elements)-1] = element
+	return true
+}
+
 func (stack *Stack[T]) SwapTopElements() {
-	if stack.size >= 2 {
-		var oldTop = stack.top
-		var oldSecond = stack.top.previous
-		var oldThird = stack.top.previous.previous
-		stack.top = oldSecond
-		stack.top.previous = oldTop
-		stack.top.previous.previous = oldThird
+	if len(stack.elements) >= 2 {
+		var last = len(stack.elements) - 1
+		stack.elements[last], stack.elements[last-1] = stack.elements[last-1], stack.elements[last]
 	}
 }
 
 func (stack *Stack[T]) RotateTopElements() {
-	if stack.size >= 3 {
-		var oldTop = stack.top
-		var oldSecond = stack.top.previous
-		var oldThird = stack.top.previous.previous
-		var oldFourth = stack.top.previous.previous.previous
-
-		stack.top = oldThird
-		stack.top.previous = oldTop
-		stack.top.previous.previous = oldSecond
-		stack.top.previous.previous.previous = oldFourth
+	if len(stack.elements) >= 3 {
+		stack.Roll(2)
 	}
 }
@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"goforth/forth"
+	"goforth/variant"
+	"reflect"
+	"testing"
+)
+
+func TestCallDefinedWord(t *testing.T) {
+	var program = forth.NewForthProgram()
+	if err := forth.ExecuteWordLine(program, ": divmod 2dup / -rot % ;"); err != nil {
+		t.Fatal(err)
+	}
+
+	var results, err = program.Call("divmod", 17, int64(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := []variant.Variant{variant.ForthInt(3), variant.ForthInt(2)}; !reflect.DeepEqual(results, expected) {
+		t.Fatalf("Expected %v, got %v", expected, results)
+	}
+
+	if program.Depth() != 0 {
+		t.Fatalf("Expected Call to leave the stack empty, got %v", program.Snapshot())
+	}
+}
+
+func TestCallBuiltinsAndHostWords(t *testing.T) {
+	var program = forth.NewForthProgram()
+	program.RegisterFunc("greet", func(name string) string { return "hello " + name })
+
+	if results, err := program.Call("greet", "forth"); err != nil || results[0] != variant.ForthString("hello forth") {
+		t.Fatalf("Expected [hello forth], got %v (%v)", results, err)
+	}
+
+	if results, err := program.Call("+", 1.5, variant.ForthInt(2)); err != nil || results[0] != variant.ForthFloat(3.5) {
+		t.Fatalf("Expected [3.5], got %v (%v)", results, err)
+	}
+
+	if results, err := program.Call("drop", true); err != nil || len(results) != 0 {
+		t.Fatalf("Expected no results, got %v (%v)", results, err)
+	}
+}
+
+func TestCallConsumesOnlyItsArguments(t *testing.T) {
+	var program = forth.NewForthProgram()
+	program.Push(1, 2)
+	if results, err := program.Call("+", 10); err != nil || len(results) != 0 {
+		t.Fatalf("Expected no results, got %v (%v)", results, err)
+	}
+
+	if snapshot := program.Snapshot(); !reflect.DeepEqual(snapshot, []variant.Variant{variant.ForthInt(1), variant.ForthInt(12)}) {
+		t.Fatalf("Expected [1 12], got %v", snapshot)
+	}
+}
+
+func TestCallErrorsRestoreTheStack(t *testing.T) {
+	var program = forth.NewForthProgram()
+	forth.ExecuteWordLine(program, `: broken 1 2 "x" + ;`)
+	program.Push("keep")
+
+	var tests = map[string]variant.ErrorCode{
+		"broken":  variant.ErrTypeMismatch,
+		"missing": variant.ErrUndefinedWord,
+		"5":       variant.ErrUndefinedWord,
+	}
+
+	for word, code := range tests {
+		var _, err = program.Call(word, 7)
+		if forthError, isForthError := err.(*variant.ForthError); !isForthError || forthError.Code != code {
+			t.Fatalf("Expected error code %v calling %s, got %v", code, word, err)
+		}
+
+		if snapshot := program.Snapshot(); !reflect.DeepEqual(snapshot, []variant.Variant{variant.ForthString("keep")}) {
+			t.Fatalf("Expected [keep] after calling %s, got %v", word, snapshot)
+		}
+	}
+
+	if _, err := program.Call("dup", struct{}{}); err == nil {
+		t.Fatal("Expected an unconvertible argument to fail")
+	}
+}
+
+func TestCallReturnsUncatchableErrors(t *testing.T) {
+	var program = forth.NewForthProgram(forth.WithMaxSteps(100))
+	forth.ExecuteWordLine(program, ": spin begin true until ;")
+	program.Push("keep")
+
+	var tests = map[string]variant.ErrorCode{
+		"spin": variant.ErrStepLimitExceeded,
+		"bye":  variant.ErrBye,
+	}
+
+	for word, code := range tests {
+		var results, err = program.Call(word, 7)
+		expectErrorCode(t, err, code)
+		if results != nil {
+			t.Fatalf("Expected no results calling %s, got %v", word, results)
+		}
+
+		if snapshot := program.Snapshot(); !reflect.DeepEqual(snapshot, []variant.Variant{variant.ForthString("keep")}) {
+			t.Fatalf("Expected [keep] after calling %s, got %v", word, snapshot)
+		}
+	}
+
+	if results, err := program.Call("+", 1, 2); err != nil || results[0] != variant.ForthInt(3) {
+		t.Fatalf("Expected the program to stay usable, got %v (%v)", results, err)
+	}
+}
+
+func TestPushPopDepthSnapshot(t *testing.T) {
+	var program = forth.NewForthProgram()
+	if err := program.Push(1, "two", 3.0, nil, variant.ForthChar('c')); err != nil {
+		t.Fatal(err)
+	}
+
+	var expected = []variant.Variant{variant.ForthInt(1), variant.ForthString("two"), variant.ForthFloat(3), variant.ForthNil{}, variant.ForthChar('c')}
+	if snapshot := program.Snapshot(); !reflect.DeepEqual(snapshot, expected) {
+		t.Fatalf("Expected %v, got %v", expected, snapshot)
+	}
+
+	if program.Depth() != 5 {
+		t.Fatalf("Expected depth 5, got %d", program.Depth())
+	}
+
+	for i := len(expected) - 1; i >= 0; i-- {
+		if value, err := program.Pop(); err != nil || value != expected[i] {
+			t.Fatalf("Expected %v, got %v (%v)", expected[i], value, err)
+		}
+	}
+
+	var _, err = program.Pop()
+	if forthError, isForthError := err.(*variant.ForthError); !isForthError || forthError.Code != variant.ErrStackUnderflow {
+		t.Fatalf("Expected a stack underflow, got %v", err)
+	}
+}
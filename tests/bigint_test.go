@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"goforth/forth"
+	"goforth/variant"
+	"math/big"
+	"testing"
+)
+
+func bigInt(digits string) variant.ForthBigInt {
+	var value, _ = new(big.Int).SetString(digits, 10)
+	return variant.NewForthBigInt(value)
+}
+
+func TestBigIntLiteral(t *testing.T) {
+	if passed, err := runTestLine("123456789012345678901234567890", bigInt("123456789012345678901234567890")); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestBigIntArithmetic(t *testing.T) {
+	if passed, err := runTestLine("100000000000000000000 1 + 3 *", bigInt("300000000000000000003")); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestBigIntDemotesWhenSmall(t *testing.T) {
+	if passed, err := runTestLine("100000000000000000000 99999999999999999999 -", variant.ForthInt(1)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestBigIntDivMod(t *testing.T) {
+	if passed, err := runTestLine("100000000000000000000 7 / 100000000000000000000 7 %", variant.ForthInt(2), bigInt("14285714285714285714")); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestBigIntDivisionByZero(t *testing.T) {
+	if passed, err := runTestError("100000000000000000000 0 /", variant.ErrDivisionByZero); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestBigIntComparisons(t *testing.T) {
+	if passed, err := runTestLine("5 100000000000000000000 < 100000000000000000000 1e20 == 100000000000000000000 5 >", variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestBigIntFloatInterop(t *testing.T) {
+	if passed, err := runTestLine("0.5 100000000000000000000 *", variant.ForthFloat(5e19)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestIntOverflowWrapsWithoutPromotion(t *testing.T) {
+	if passed, err := runTestLine("9223372036854775807 1 +", variant.ForthInt(-9223372036854775808)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestBigIntPromotion(t *testing.T) {
+	var program = forth.NewForthProgram()
+	program.SetBigIntPromotion(true)
+	if passed, err := runProgramTestLine(program, "9223372036854775807 1 + 4611686018427387904 4 * -9223372036854775808 negate 4611686018427387904 2*", bigInt("9223372036854775808"), bigInt("9223372036854775808"), bigInt("18446744073709551616"), bigInt("9223372036854775808")); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestBigIntFactorial(t *testing.T) {
+	var program = forth.NewForthProgram()
+	program.SetBigIntPromotion(true)
+	if passed, err := runProgramTestLine(program, "1 26 1 do i * loop", bigInt("15511210043330985984000000")); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestBigIntMathWords(t *testing.T) {
+	if passed, err := runTestLine("-100000000000000000000 abs 100000000000000000000 2/ 100000000000000000000 3 2 */", bigInt("150000000000000000000"), bigInt("50000000000000000000"), bigInt("100000000000000000000")); !passed {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"goforth/forth"
+	"goforth/variant"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileWords(t *testing.T) {
+	var path = filepath.Join(t.TempDir(), "note.txt")
+	var program = forth.NewForthProgram()
+	var line = `"hello file" "` + path + `" write-file "` + path + `" read-file "` + path + `" file-exists? "` + path + `.missing" file-exists?`
+	if passed, err := runProgramTestLine(program, line, variant.ForthBool(false), variant.ForthBool(true), variant.ForthString("hello file"), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runProgramTestError(program, `"`+path+`.missing" read-file`, variant.ErrNonExistentFile); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runProgramTestError(program, `"x" "`+t.TempDir()+`" write-file`, variant.ErrFileIO); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestSandboxCannotReachFilesystem(t *testing.T) {
+	var path = filepath.Join(t.TempDir(), "secret.txt")
+	os.WriteFile(path, []byte("secret"), 0o644)
+
+	var sandbox = forth.NewForthProgram(forth.WithCapabilities(forth.AllCapabilities &^ forth.CapabilityFS))
+	forth.ExecuteWordLine(sandbox, `: steal "`+path+`" read-file ;`)
+
+	var tests = []string{
+		`"` + path + `" read-file`,
+		`"` + path + `" file-exists?`,
+		`"pwned" "` + path + `" write-file`,
+		"steal",
+		`"` + path + `" ' read-file execute`,
+		`"` + path + `" ' read-file catch throw`,
+	}
+
+	for _, line := range tests {
+		if passed, err := runProgramTestError(sandbox, line, variant.ErrWordNotPermitted); !passed {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := sandbox.Call("read-file", path); err == nil || !strings.Contains(err.Error(), "not permitted") {
+		t.Fatalf("Expected Call to be refused, got %v", err)
+	}
+
+	if contents, _ := os.ReadFile(path); string(contents) != "secret" {
+		t.Fatalf("The sandbox modified the file: %q", contents)
+	}
+}
+
+func TestDeniedWordsAreNotUnrecognized(t *testing.T) {
+	var sandbox = forth.NewForthProgram(forth.WithCapabilities(forth.NoCapabilities))
+	sandbox.RegisterFunc("host-word", func() int64 { return 1 })
+
+	for _, line := range []string{"1 .", "1 emit", ".s", "key", "rand", "randf", "utime", "0 ms", "time&date", "host-word"} {
+		if passed, err := runProgramTestError(sandbox, line, variant.ErrWordNotPermitted); !passed {
+			t.Fatal(err)
+		}
+	}
+
+	if passed, err := runProgramTestError(sandbox, "no-such-word", variant.ErrUndefinedWord); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runProgramTestLine(sandbox, "clear 1 2 + dup *", variant.ForthInt(9), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestCapabilitySubsets(t *testing.T) {
+	var output strings.Builder
+	var program = forth.NewForthProgram(forth.WithStdout(&output), forth.WithCapabilities(forth.CapabilityIO|forth.CapabilityTime))
+	if err := forth.ExecuteWordLine(program, "1 . 0 ms utime drop"); err != nil {
+		t.Fatal(err)
+	}
+
+	if passed, err := runProgramTestError(program, "rand", variant.ErrWordNotPermitted); !passed {
+		t.Fatal(err)
+	}
+
+	if program.Capabilities() != forth.CapabilityIO|forth.CapabilityTime || program.Capabilities().String() != "io,time" {
+		t.Fatalf("Unexpected capabilities %v", program.Capabilities())
+	}
+}
+
+func TestParseCapabilities(t *testing.T) {
+	var tests = map[string]forth.Capability{
+		"":           forth.NoCapabilities,
+		"io, rand":   forth.CapabilityIO | forth.CapabilityRand,
+		"FS,time,fs": forth.CapabilityFS | forth.CapabilityTime,
+		"all":        forth.AllCapabilities,
+		"host,io":    forth.CapabilityHost | forth.CapabilityIO,
+	}
+
+	for text, expected := range tests {
+		if capabilities, ok := forth.ParseCapabilities(text); !ok || capabilities != expected {
+			t.Fatalf("Expected %q to parse as %v, got %v", text, expected, capabilities)
+		}
+	}
+
+	if _, ok := forth.ParseCapabilities("io,network"); ok {
+		t.Fatal("Expected an unknown capability to be rejected")
+	}
+}
+
+func TestTimeWords(t *testing.T) {
+	var program = forth.NewForthProgram()
+	if err := forth.ExecuteWordLine(program, "time&date utime"); err != nil {
+		t.Fatal(err)
+	}
+
+	if program.Depth() != 7 {
+		t.Fatalf("Expected 7 values from time&date utime, got %v", program.Snapshot())
+	}
+
+	var year, _ = program.Snapshot()[5].ToInt()
+	if year < 2000 {
+		t.Fatalf("Unexpected year %v", year)
+	}
+}
@@ -0,0 +1,103 @@
+package tests
+
+import (
+	"goforth/forth"
+	"goforth/variant"
+	"testing"
+)
+
+func TestCharLiterals(t *testing.T) {
+	if passed, err := runTestLine(`'a' 'é' '\n' char xyz bl`, variant.ForthChar(' '), variant.ForthChar('x'), variant.ForthChar('\n'), variant.ForthChar('é'), variant.ForthChar('a'), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestBracketCharInDefinition(t *testing.T) {
+	var program = forth.NewForthProgram()
+	if err := forth.ExecuteWordLine(program, ": star [char] * ;"); err != nil {
+		t.Fatal(err)
+	}
+
+	if passed, err := runProgramTestLine(program, "star star", variant.ForthChar('*'), variant.ForthChar('*'), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestCharPrinting(t *testing.T) {
+	var tests = map[string]string{
+		"'a' .":                             "a",
+		"'h' emit 'i' emit":                 "hi",
+		"104 emit 'i' emit":                 "hi",
+		"'a' 5 0 do dup emit 1 + loop drop": "abcde",
+	}
+
+	for line, expected := range tests {
+		if output := captureOutput(line); output != expected {
+			t.Fatalf("\nExpression: %s\nExpected: %s\nGot: %v", line, expected, output)
+		}
+	}
+}
+
+func TestCharArithmetic(t *testing.T) {
+	if passed, err := runTestLine(`'a' 2 + 'z' 'a' - 'b' 1 - 1 'a' +`, variant.ForthChar('b'), variant.ForthChar('a'), variant.ForthInt(25), variant.ForthChar('c'), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestLine(`"ab" 'c' + 'x' 'y' +`, variant.ForthString("xy"), variant.ForthString("abc"), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestError("'a' -1000 +", variant.ErrResultOutOfRange); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestError("'a' 2 *", variant.ErrTypeMismatch); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestCharComparison(t *testing.T) {
+	if passed, err := runTestLine("'a' 'b' < 'a' 'a' == 'a' 'b' ==", variant.ForthBool(false), variant.ForthBool(true), variant.ForthBool(true), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestCharComparesWithInt(t *testing.T) {
+	var line = "'a' 97 == 97 'a' == 'a' 98 != 'a' 98 < 98 'a' > 'a' 97 <= 96 'a' >= 'b' 97 >"
+	var expected = []variant.Variant{variant.ForthBool(true), variant.ForthBool(false), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), nil}
+	if passed, err := runTestLine(line, expected...); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestLine("new-map 'a' \"char\" put 97 lookup", variant.ForthString("char"), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestError("'a' 97.0 ==", variant.ErrTypeMismatch); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestCharConversions(t *testing.T) {
+	if passed, err := runTestLine(`97 >char "λ" >char 'a' char>int 'λ' char>string`, variant.ForthString("λ"), variant.ForthInt(97), variant.ForthChar('λ'), variant.ForthChar('a'), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestError(`"ab" >char`, variant.ErrTypeMismatch); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestError(`-1 >char`, variant.ErrTypeMismatch); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestError(`5 char>int`, variant.ErrTypeMismatch); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestTickStillWorks(t *testing.T) {
+	if passed, err := runTestLine("' dup", variant.ForthXt("dup"), nil); !passed {
+		t.Fatal(err)
+	}
+}
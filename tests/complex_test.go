@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"goforth/variant"
+	"math"
+	"testing"
+)
+
+func TestComplexLiteral(t *testing.T) {
+	if passed, err := runTestLine("3+4i 2i", variant.ForthComplex(2i), variant.ForthComplex(3+4i)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestComplexArithmetic(t *testing.T) {
+	if passed, err := runTestLine("1+2i 3-1i * 2 +", variant.ForthComplex(7+5i)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestComplexDivisionByZero(t *testing.T) {
+	for _, line := range []string{"1+2i 0i /", "1+2i 0 /", "3 0i /", "1.5 0+0i /", "1/2r 0i /"} {
+		if passed, err := runTestError(line, variant.ErrDivisionByZero); !passed {
+			t.Fatal(err)
+		}
+	}
+
+	if passed, err := runTestLine("2+4i 2i /", variant.ForthComplex(2-1i)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestNonFiniteComplexLiteralsAreRejected(t *testing.T) {
+	for _, line := range []string{"infi", "nani", "1+infi", "Infi", "1e400i"} {
+		if passed, err := runTestError(line, variant.ErrUndefinedWord); !passed {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestComplexPromotion(t *testing.T) {
+	if passed, err := runTestLine("2 1i - 0.5 2+2i * 1/2r 1i +", variant.ForthComplex(0.5+1i), variant.ForthComplex(1+1i), variant.ForthComplex(2-1i)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestComplexEquality(t *testing.T) {
+	if passed, err := runTestLine("3 3+0i == 3+0i 3.5 !=", variant.ForthBool(true), variant.ForthBool(true)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestComplexOrderingIsInvalid(t *testing.T) {
+	if passed, err := runTestError("1i 2i <", variant.ErrTypeMismatch); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestComplexParts(t *testing.T) {
+	if passed, err := runTestLine("3+4i dup real swap imag", variant.ForthFloat(4), variant.ForthFloat(3)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestComplexAbsArgConj(t *testing.T) {
+	if passed, err := runTestLine("3+4i abs 1i carg 3+4i conj", variant.ForthComplex(3-4i), variant.ForthFloat(math.Pi/2), variant.ForthFloat(5)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestComplexConstructors(t *testing.T) {
+	if passed, err := runTestLine("1.5 -2 complex 2 0 polar", variant.ForthComplex(2), variant.ForthComplex(1.5-2i)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestComplexSqrtExp(t *testing.T) {
+	if passed, err := runTestLine("-4 csqrt 0 cexp", variant.ForthComplex(1), variant.ForthComplex(2i)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestComplexFormatting(t *testing.T) {
+	if output := captureOutput("3-4i ."); output != "(3-4i)" {
+		t.Fatalf("\nExpression: 3-4i .\nExpected: (3-4i)\nGot: %v", output)
+	}
+}
+
+func TestLoopIndexIsNotComplex(t *testing.T) {
+	if passed, err := runTestLine("1 0 do i loop", variant.ForthInt(0)); !passed {
+		t.Fatal(err)
+	}
+}
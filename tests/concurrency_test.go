@@ -0,0 +1,144 @@
+package tests
+
+import (
+	"fmt"
+	"goforth/forth"
+	"goforth/variant"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const concurrentPrograms = 16
+
+func TestConcurrentPrograms(t *testing.T) {
+	var wait sync.WaitGroup
+	var failures = make(chan string, concurrentPrograms)
+	for worker := range concurrentPrograms {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+
+			var output strings.Builder
+			var program = forth.NewForthProgram(forth.WithStdout(&output))
+			program.RegisterFunc("worker", func() int64 { return int64(worker) })
+			forth.ExecuteWordLine(program, fmt.Sprintf(": scaled %d * ;", worker))
+			for range 100 {
+				if err := forth.ExecuteWordLine(program, "0 10 0 do i scaled + loop worker + . { 1 2 } 0 ' + reduce drop"); err != nil {
+					failures <- err.Error()
+					return
+				}
+			}
+
+			var expected = strings.Repeat(fmt.Sprint(45*worker+worker), 100)
+			if output.String() != expected || program.Depth() != 0 {
+				failures <- fmt.Sprintf("worker %d printed %q with %v left on the stack", worker, output.String(), program.Snapshot())
+			}
+		}()
+	}
+
+	wait.Wait()
+	close(failures)
+	for failure := range failures {
+		t.Error(failure)
+	}
+}
+
+func TestCloneIsIndependent(t *testing.T) {
+	var base = forth.NewForthProgram()
+	forth.ExecuteWordLine(base, ": greeting \"hello\" ;")
+	forth.ExecuteWordLine(base, "1 2 3")
+
+	var clone = base.Clone()
+	forth.ExecuteWordLine(clone, ": greeting \"bonjour\" ;")
+	forth.ExecuteWordLine(clone, "drop 30 greeting")
+	clone.RegisterFunc("only-clone", func() int64 { return 1 })
+	forth.ExecuteWordLine(base, "greeting")
+
+	var expectedBase = []variant.Variant{variant.ForthInt(1), variant.ForthInt(2), variant.ForthInt(3), variant.ForthString("hello")}
+	if snapshot := base.Snapshot(); !reflect.DeepEqual(snapshot, expectedBase) {
+		t.Fatalf("Expected the base stack %v, got %v", expectedBase, snapshot)
+	}
+
+	var expectedClone = []variant.Variant{variant.ForthInt(1), variant.ForthInt(2), variant.ForthInt(30), variant.ForthString("bonjour")}
+	if snapshot := clone.Snapshot(); !reflect.DeepEqual(snapshot, expectedClone) {
+		t.Fatalf("Expected the clone stack %v, got %v", expectedClone, snapshot)
+	}
+
+	if passed, err := runProgramTestError(base, "only-clone", variant.ErrUndefinedWord); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestConcurrentClonesOfPreloadedProgram(t *testing.T) {
+	var base = forth.NewForthProgram()
+	base.SetBigIntPromotion(true)
+	forth.ExecuteWordLine(base, ": square dup * ;")
+	forth.ExecuteWordLine(base, ": sum-squares 0 swap 0 do i square + loop ;")
+	forth.ExecuteWordLine(base, "new-map \"base\" true put")
+
+	var wait sync.WaitGroup
+	var failures = make(chan string, concurrentPrograms)
+	for worker := range concurrentPrograms {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+
+			var program = base.Clone()
+			forth.ExecuteWordLine(program, fmt.Sprintf(": square %d + ;", worker))
+			var results, err = program.Call("sum-squares", 10)
+			if err != nil {
+				failures <- err.Error()
+			} else if expected := variant.ForthInt(45 + 10*worker); results[0] != expected {
+				failures <- fmt.Sprintf("worker %d expected %v, got %v", worker, expected, results[0])
+			}
+		}()
+	}
+
+	wait.Wait()
+	close(failures)
+	for failure := range failures {
+		t.Error(failure)
+	}
+
+	if passed, err := runProgramTestLine(base, "drop 4 sum-squares 9223372036854775807 1 +", bigInt("9223372036854775808"), variant.ForthInt(14), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestCloneOptions(t *testing.T) {
+	var baseOutput, cloneOutput strings.Builder
+	var base = forth.NewForthProgram(forth.WithStdin(strings.NewReader("base\n")), forth.WithStdout(&baseOutput))
+	forth.ExecuteWordLine(base, ": echo accept . ;")
+
+	var wait sync.WaitGroup
+	var failures = make(chan string, concurrentPrograms)
+	for worker := range concurrentPrograms {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+
+			var input = fmt.Sprintf("line %d\n", worker)
+			var output strings.Builder
+			var clone = base.Clone(forth.WithStdin(strings.NewReader(input)), forth.WithStdout(&output))
+			if err := forth.ExecuteWordLine(clone, "echo"); err != nil {
+				failures <- err.Error()
+			} else if output.String() != strings.TrimSuffix(input, "\n") {
+				failures <- fmt.Sprintf("worker %d read %q", worker, output.String())
+			}
+		}()
+	}
+
+	wait.Wait()
+	close(failures)
+	for failure := range failures {
+		t.Error(failure)
+	}
+
+	forth.ExecuteWordLine(base.Clone(forth.WithStdout(&cloneOutput)), "echo")
+	forth.ExecuteWordLine(base, "1 .")
+	if baseOutput.String() != "1" || cloneOutput.String() != "base" {
+		t.Fatalf("Expected the clone to share stdin but not stdout, got %q and %q", baseOutput.String(), cloneOutput.String())
+	}
+}
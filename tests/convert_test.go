@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"errors"
+	"goforth/forth"
+	"goforth/variant"
+	"math/big"
+	"testing"
+)
+
+func TestTypeOf(t *testing.T) {
+	var tests = map[string]string{
+		"1 typeof":                    "int",
+		"1.5 typeof":                  "float",
+		`"x" typeof`:                  "string",
+		"true typeof":                 "bool",
+		"'x' typeof":                  "char",
+		"nil typeof":                  "nil",
+		"{ 1 } typeof":                "list",
+		"new-map typeof":              "map",
+		"' dup typeof":                "xt",
+		"1/3r typeof":                 "rational",
+		"1.50d typeof":                "decimal",
+		"2i typeof":                   "complex",
+		"99999999999999999999 typeof": "bigint",
+	}
+
+	for line, expected := range tests {
+		if passed, err := runTestLine(line, variant.ForthString(expected), nil); !passed {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestToInt(t *testing.T) {
+	if passed, err := runTestLine(`3.9 >int -3.9 >int " 42 " >int true >int 'a' >int 7/2r >int -2.50d >int`, variant.ForthInt(-2), variant.ForthInt(3), variant.ForthInt(97), variant.ForthInt(1), variant.ForthInt(42), variant.ForthInt(-3), variant.ForthInt(3), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestToIntErrors(t *testing.T) {
+	var tests = map[string]variant.ErrorCode{
+		`"3.5" >int`:                  variant.ErrInvalidNumericArgument,
+		`"abc" >int`:                  variant.ErrInvalidNumericArgument,
+		`"99999999999999999999" >int`: variant.ErrResultOutOfRange,
+		"1e30 >int":                   variant.ErrResultOutOfRange,
+		"99999999999999999999 >int":   variant.ErrResultOutOfRange,
+		"nil >int":                    variant.ErrTypeMismatch,
+		"{ 1 } >int":                  variant.ErrTypeMismatch,
+		"1+2i >int":                   variant.ErrTypeMismatch,
+		">int":                        variant.ErrStackUnderflow,
+	}
+
+	for line, code := range tests {
+		if passed, err := runTestError(line, code); !passed {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestToFloat(t *testing.T) {
+	if passed, err := runTestLine(`3 >float "2.5" >float 1/4r >float false >float`, variant.ForthFloat(0), variant.ForthFloat(0.25), variant.ForthFloat(2.5), variant.ForthFloat(3), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestError(`"two" >float`, variant.ErrInvalidNumericArgument); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestToStringAndBool(t *testing.T) {
+	if passed, err := runTestLine(`12 >string 0.5 >string 'c' >string { 1 nil } >string`, variant.ForthString("{ 1 nil }"), variant.ForthString("c"), variant.ForthString("0.5"), variant.ForthString("12"), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestLine(`0 >bool 2 >bool nil >bool { 0 } >bool`, variant.ForthBool(true), variant.ForthBool(false), variant.ForthBool(true), variant.ForthBool(false), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestStringToBool(t *testing.T) {
+	if passed, err := runTestLine(`"true" >bool "false" >bool " FALSE " >bool "True" >bool`, variant.ForthBool(true), variant.ForthBool(false), variant.ForthBool(false), variant.ForthBool(true), nil); !passed {
+		t.Fatal(err)
+	}
+
+	for _, line := range []string{`"" >bool`, `"yes" >bool`, `"0" >bool`} {
+		if passed, err := runTestError(line, variant.ErrTypeMismatch); !passed {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestTypePredicates(t *testing.T) {
+	if passed, err := runTestLine(`1 int? 1.0 int? 99999999999999999999 int? 1.0 float? "s" string? 's' string? 1/2r number? "1" number?`, variant.ForthBool(false), variant.ForthBool(true), variant.ForthBool(false), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(false), variant.ForthBool(true), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestLine(`true bool? 'c' char? { } list? new-map map? ' dup xt? 0 bool?`, variant.ForthBool(false), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestDoLoopRejectsFloatBounds(t *testing.T) {
+	if passed, err := runTestError("10.5 0 do i loop", variant.ErrTypeMismatch); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestError("do", variant.ErrStackUnderflow); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestConversionAPI(t *testing.T) {
+	var value variant.Variant = variant.NewForthRational(big.NewRat(-7, 2))
+	if converted, err := value.ToInt(); err != nil || converted != -3 {
+		t.Fatalf("Expected -3, got %v (%v)", converted, err)
+	}
+
+	if converted, err := value.ToFloat(); err != nil || converted != -3.5 {
+		t.Fatalf("Expected -3.5, got %v (%v)", converted, err)
+	}
+
+	var _, err = variant.ForthNil{}.ToFloat()
+	var forthError *variant.ForthError
+	if !errors.As(err, &forthError) || forthError.Code != variant.ErrTypeMismatch {
+		t.Fatalf("Expected a type mismatch, got %v", err)
+	}
+}
+
+type opaque struct{ variant.ForthNil }
+
+func (opaque) ToInt() (variant.ForthInt, error)     { return 0, errors.New("opaque value") }
+func (opaque) ToFloat() (variant.ForthFloat, error) { return 0, errors.New("opaque value") }
+
+func TestConversionErrorsFromOtherTypes(t *testing.T) {
+	for _, line := range []string{">int", ">float"} {
+		var program = forth.NewForthProgram()
+		program.Push(opaque{})
+		if passed, err := runProgramTestError(program, line, variant.ErrTypeMismatch); !passed {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"goforth/variant"
+	"math/big"
+	"testing"
+)
+
+func rational(numerator int64, denominator int64) variant.ForthRational {
+	return variant.NewForthRational(big.NewRat(numerator, denominator))
+}
+
+func decimal(text string) variant.ForthDecimal {
+	var value, _ = variant.ParseForthDecimal(text + "d")
+	return value
+}
+
+func TestRationalLiteral(t *testing.T) {
+	if passed, err := runTestLine("1/3r 2/4r", rational(1, 2), rational(1, 3)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestRationalArithmetic(t *testing.T) {
+	if passed, err := runTestLine("1/3r 1/6r + 3 * 1/2r /", rational(3, 1)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestRationalIsExact(t *testing.T) {
+	if passed, err := runTestLine("1/10r 2/10r + 3/10r ==", variant.ForthBool(true)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestRationalWithIntOnLeft(t *testing.T) {
+	if passed, err := runTestLine("1 1/3r - 2 1/4r <", variant.ForthBool(false), rational(2, 3)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestRationalDivisionByZero(t *testing.T) {
+	if passed, err := runTestError("1/3r 0 /", variant.ErrDivisionByZero); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestRationalFormatting(t *testing.T) {
+	if output := captureOutput("1/3r . 6/3r ."); output != "1/32" {
+		t.Fatalf("\nExpression: 1/3r . 6/3r .\nExpected: 1/32\nGot: %v", output)
+	}
+}
+
+func TestDecimalLiteral(t *testing.T) {
+	if passed, err := runTestLine("12.50d -0.05d", decimal("-0.05"), decimal("12.50")); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestDecimalAddition(t *testing.T) {
+	if passed, err := runTestLine("0.10d 0.20d + 0.30d ==", variant.ForthBool(true)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestDecimalScale(t *testing.T) {
+	if passed, err := runTestLine("12.50d 1.005d + 19.99d 3 * 1.5d 0.25d *", decimal("0.375"), decimal("59.97"), decimal("13.505")); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestDecimalDivisionRoundsHalfEven(t *testing.T) {
+	if passed, err := runTestLine("10.00d 3 / 0.25d 10 / 0.35d 10 /", decimal("0.04"), decimal("0.02"), decimal("3.33")); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestDecimalDivisionKeepsOperandScale(t *testing.T) {
+	if passed, err := runTestLine("1d 3 / 1.000d 3 / 2d 3 / 1d 0.30d / 1d 3/1r /", rational(1, 3), decimal("3.33"), decimal("1"), decimal("0.333"), decimal("0")); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestDecimalFormatting(t *testing.T) {
+	if output := captureOutput("12.50d . 0.05d negate ."); output != "12.50-0.05" {
+		t.Fatalf("\nExpression: 12.50d . 0.05d negate .\nExpected: 12.50-0.05\nGot: %v", output)
+	}
+}
+
+func TestDecimalWithRational(t *testing.T) {
+	if passed, err := runTestLine("0.5d 1/3r +", rational(5, 6)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestExactWithFloatBecomesFloat(t *testing.T) {
+	if passed, err := runTestLine("1/2r 0.25 + 0.5 1.5d *", variant.ForthFloat(0.75), variant.ForthFloat(0.75)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestDecimalMod(t *testing.T) {
+	if passed, err := runTestLine("10.25d 3 %", decimal("1.25")); !passed {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,141 @@
+package tests
+
+import (
+	"goforth/forth"
+	"goforth/variant"
+	"testing"
+)
+
+func TestDivisionByZero(t *testing.T) {
+	if passed, err := runTestError("5 0 /", variant.ErrDivisionByZero); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestModulusByZero(t *testing.T) {
+	if passed, err := runTestError("5 0 %", variant.ErrDivisionByZero); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestCatchDivisionByZero(t *testing.T) {
+	var program = forth.NewForthProgram()
+	forth.ExecuteWordLine(program, ": divide / ;")
+	if passed, err := runProgramTestLine(program, "1 5 0 ' divide catch", variant.ForthInt(-10), variant.ForthInt(0), variant.ForthInt(5), variant.ForthInt(1), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestCatchWithoutError(t *testing.T) {
+	if passed, err := runTestLine("6 3 ' / catch", variant.ForthInt(0), variant.ForthInt(2), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestThrow(t *testing.T) {
+	var program = forth.NewForthProgram()
+	forth.ExecuteWordLine(program, ": fail 42 throw ;")
+	if passed, err := runProgramTestError(program, "fail", variant.ErrorCode(42)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestThrowZeroDoesNothing(t *testing.T) {
+	if passed, err := runTestLine("7 0 throw", variant.ForthInt(7), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestExecute(t *testing.T) {
+	var program = forth.NewForthProgram()
+	forth.ExecuteWordLine(program, ": square dup * ;")
+	if passed, err := runProgramTestLine(program, "4 ' square execute", variant.ForthInt(16), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestTickUndefinedWord(t *testing.T) {
+	if passed, err := runTestError("' frobnicate", variant.ErrUndefinedWord); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestLoopInsideDefinition(t *testing.T) {
+	var program = forth.NewForthProgram()
+	forth.ExecuteWordLine(program, ": count-up 3 0 do i loop ;")
+	if passed, err := runProgramTestLine(program, "count-up 10", variant.ForthInt(10), variant.ForthInt(2), variant.ForthInt(1), variant.ForthInt(0), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestNestedLoopIndex(t *testing.T) {
+	if passed, err := runTestLine("2 0 do 2 0 do j loop loop", variant.ForthInt(1), variant.ForthInt(1), variant.ForthInt(0), variant.ForthInt(0), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckedArithmeticOverflow(t *testing.T) {
+	var program = forth.NewForthProgram()
+	program.SetCheckedArithmetic(true)
+	if passed, err := runProgramTestError(program, "9223372036854775807 1 +", variant.ErrResultOutOfRange); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckedArithmeticMultiply(t *testing.T) {
+	var program = forth.NewForthProgram()
+	program.SetCheckedArithmetic(true)
+	if passed, err := runProgramTestError(program, "4611686018427387904 2 *", variant.ErrResultOutOfRange); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckedArithmeticShifts(t *testing.T) {
+	var program = forth.NewForthProgram()
+	program.SetCheckedArithmetic(true)
+	for _, line := range []string{"4611686018427387904 2*", "-4611686018427387905 2*", "1 63 lshift", "3 62 lshift", "1 64 lshift", "-1 64 lshift"} {
+		if passed, err := runProgramTestError(program, line, variant.ErrResultOutOfRange); !passed {
+			t.Fatal(err)
+		}
+	}
+
+	if passed, err := runProgramTestLine(program, "-4611686018427387904 2* 1 62 lshift -1 63 lshift 0 70 lshift", variant.ForthInt(0), variant.ForthInt(-9223372036854775808), variant.ForthInt(4611686018427387904), variant.ForthInt(-9223372036854775808), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestLine("4611686018427387904 2* 1 64 lshift", variant.ForthInt(0), variant.ForthInt(-9223372036854775808), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckedArithmeticInRange(t *testing.T) {
+	var program = forth.NewForthProgram()
+	program.SetCheckedArithmetic(true)
+	if passed, err := runProgramTestLine(program, "9223372036854775806 1+ -9223372036854775807 1 -", variant.ForthInt(-9223372036854775808), variant.ForthInt(9223372036854775807), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestUncheckedArithmeticWraps(t *testing.T) {
+	if passed, err := runTestLine("9223372036854775807 1 +", variant.ForthInt(-9223372036854775808), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestSymmetricDivision(t *testing.T) {
+	if passed, err := runTestLine("-7 2 sm/rem 7 -2 sm/rem", variant.ForthInt(-3), variant.ForthInt(1), variant.ForthInt(-3), variant.ForthInt(-1), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFlooredDivision(t *testing.T) {
+	if passed, err := runTestLine("-7 2 fm/mod 7 -2 fm/mod", variant.ForthInt(-4), variant.ForthInt(-1), variant.ForthInt(-4), variant.ForthInt(1), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFlooredDivisionByZero(t *testing.T) {
+	if passed, err := runTestError("7 0 fm/mod", variant.ErrDivisionByZero); !passed {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"fmt"
+	"goforth/forth"
+	"goforth/variant"
+	"testing"
+)
+
+func runFloatTestLine(line string, expectedFloats []variant.ForthFloat, expectedValues ...variant.Variant) (passed bool, err string) {
+	var program = forth.NewForthProgram()
+	program.SetSeparateFloatStack(true)
+	forth.ExecuteWordLine(program, line)
+
+	for _, expectedFloat := range expectedFloats {
+		if program.FloatStackTop() == nil {
+			return false, fmt.Sprintf("\nExpression: %v\nExpected float: %v\nGot: nil", line, expectedFloat)
+		}
+
+		if actualFloat := *program.FloatStackTop(); actualFloat != expectedFloat {
+			return false, fmt.Sprintf("\nExpression: %v\nExpected float: %v\nGot: %v", line, expectedFloat, actualFloat)
+		}
+
+		program.FloatStackPop()
+	}
+
+	if program.FloatStackTop() != nil {
+		return false, fmt.Sprintf("\nExpression: %v\nExpected an empty float stack\nGot: %v", line, *program.FloatStackTop())
+	}
+
+	for _, expectedValue := range expectedValues {
+		if program.StackTop() == nil {
+			if expectedValue == nil {
+				continue
+			} else {
+				return false, fmt.Sprintf("\nExpression: %v\nExpected: %v\nGot: nil", line, expectedValue)
+			}
+		}
+
+		if actualValue := *program.StackTop(); !valuesMatch(actualValue, expectedValue) {
+			return false, fmt.Sprintf("\nExpression: %v\nExpected: %v\nGot: %v", line, expectedValue, actualValue)
+		}
+
+		program.StackPop()
+	}
+
+	return true, ""
+}
+
+func TestFloatLiteralsUseFloatStack(t *testing.T) {
+	if passed, err := runFloatTestLine("1 2.5 3", []variant.ForthFloat{2.5}, variant.ForthInt(3), variant.ForthInt(1), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFloatAdd(t *testing.T) {
+	if passed, err := runFloatTestLine("7 1.5 2.25 f+", []variant.ForthFloat{3.75}, variant.ForthInt(7), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFloatSubtractDivide(t *testing.T) {
+	if passed, err := runFloatTestLine("10.0 4.0 f- 2.0 f/", []variant.ForthFloat{3}, nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFloatStackWords(t *testing.T) {
+	if passed, err := runFloatTestLine("1.5 2.5 3.5 frot fover fswap fdup fdrop", []variant.ForthFloat{1.5, 3.5, 3.5, 2.5}, nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFloatDepthIgnoresDataStack(t *testing.T) {
+	if passed, err := runFloatTestLine("1 2 1.5 2.5 fdepth", []variant.ForthFloat{2.5, 1.5}, variant.ForthInt(2), variant.ForthInt(2), variant.ForthInt(1), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFloatComparisonsPushFlags(t *testing.T) {
+	if passed, err := runFloatTestLine("1.5 2.5 f< -0.5 f0< 0.0 f0=", nil, variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFloatConversions(t *testing.T) {
+	if passed, err := runFloatTestLine("3 s>f 1.5 f+ 7.75 f>s", []variant.ForthFloat{4.5}, variant.ForthInt(7), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFloatWordsInMixedMode(t *testing.T) {
+	if passed, err := runTestLine("1.5 2 f+ fdup f*", variant.ForthFloat(12.25), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestMixedModeKeepsFloatsOnDataStack(t *testing.T) {
+	if passed, err := runTestLine("1 2.5 +", variant.ForthFloat(3.5), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFloatExponentLiterals(t *testing.T) {
+	if passed, err := runFloatTestLine("1e 1.5e0 2E 2.5e-1", []variant.ForthFloat{0.25, 2, 1.5, 1}, nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestError("1e", variant.ErrUndefinedWord); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestDoubleCellConversions(t *testing.T) {
+	if passed, err := runFloatTestLine("5 0 d>f -1 -1 d>f 0 1 d>f", []variant.ForthFloat{18446744073709551616, -1, 5}, nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runFloatTestLine("-2.5e f>d 18446744073709551616e0 f>d", nil, variant.ForthInt(1), variant.ForthInt(0), variant.ForthInt(-1), variant.ForthInt(-2), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestError("1e300 f>d", variant.ErrResultOutOfRange); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFloatVariables(t *testing.T) {
+	if passed, err := runFloatTestLine("fvariable x fvariable y x f@ 1.5e x f! 2.5e y f! x f@ y f@ f+", []variant.ForthFloat{4, 0}, nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestLine("fvariable x 1.5 x f! x f@ 2 f*", variant.ForthFloat(3), nil); !passed {
+		t.Fatal(err)
+	}
+
+	for _, line := range []string{"0 f@", "fvariable x 1.5 x 1 + f!", "-1 f@"} {
+		if passed, err := runTestError(line, variant.ErrInvalidMemoryAddress); !passed {
+			t.Fatal(err)
+		}
+	}
+}
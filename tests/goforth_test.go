@@ -4,12 +4,33 @@ import (
 	"fmt"
 	"goforth/forth"
 	"goforth/variant"
+	"reflect"
+	"strings"
 	"testing"
 )
 
+func valuesMatch(actual variant.Variant, expected variant.Variant) bool {
+	var actualType = reflect.TypeOf(actual)
+	if actualType != reflect.TypeOf(expected) {
+		return false
+	}
+
+	if actualType.Comparable() && actual == expected {
+		return true
+	}
+
+	return fmt.Sprint(actual) == fmt.Sprint(expected)
+}
+
 func runTestLine(line string, expectedValues ...variant.Variant) (passed bool, err string) {
 	var program = forth.NewForthProgram()
-	forth.ExecuteWordLine(&program, line)
+	return runProgramTestLine(program, line, expectedValues...)
+}
+
+func runProgramTestLine(program *forth.ForthProgram, line string, expectedValues ...variant.Variant) (passed bool, err string) {
+	if executeErr := forth.ExecuteWordLine(program, line); executeErr != nil {
+		return false, fmt.Sprintf("\nExpression: %v\nUnexpected error: %v", line, executeErr)
+	}
 
 	for _, expectedValue := range expectedValues {
 		if program.StackTop() == nil {
@@ -21,7 +42,7 @@ func runTestLine(line string, expectedValues ...variant.Variant) (passed bool, e
 		}
 
 		var actualValue = *program.StackTop()
-		if actualValue != expectedValue {
+		if !valuesMatch(actualValue, expectedValue) {
 			return false, fmt.Sprintf("\nExpression: %v\nExpected: %v\nGot: %v", line, expectedValue, actualValue)
 		}
 
@@ -31,6 +52,26 @@ func runTestLine(line string, expectedValues ...variant.Variant) (passed bool, e
 	return true, ""
 }
 
+func runTestError(line string, expectedCode variant.ErrorCode) (passed bool, err string) {
+	var program = forth.NewForthProgram()
+	return runProgramTestError(program, line, expectedCode)
+}
+
+func runProgramTestError(program *forth.ForthProgram, line string, expectedCode variant.ErrorCode) (passed bool, err string) {
+	var executeErr = forth.ExecuteWordLine(program, line)
+
+	var forthError, isForthError = executeErr.(*variant.ForthError)
+	if !isForthError {
+		return false, fmt.Sprintf("\nExpression: %v\nExpected error code: %v\nGot: %v", line, expectedCode, executeErr)
+	}
+
+	if forthError.Code != expectedCode {
+		return false, fmt.Sprintf("\nExpression: %v\nExpected error code: %v\nGot: %v (%v)", line, expectedCode, forthError.Code, forthError)
+	}
+
+	return true, ""
+}
+
 func TestAdd(t *testing.T) {
 	if passed, err := runTestLine("5 3 +", variant.ForthInt(8)); !passed {
 		t.Fatal(err)
@@ -114,3 +155,136 @@ func TestUntilLoop(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func captureOutput(line string) string {
+	var output strings.Builder
+	var program = forth.NewForthProgram(forth.WithStdout(&output))
+	forth.ExecuteWordLine(program, line)
+	return output.String()
+}
+
+func TestPrintStack(t *testing.T) {
+	if output := captureOutput("1 2 3 .s"); output != "<3> 1 2 3 " {
+		t.Fatalf("\nExpression: 1 2 3 .s\nExpected: <3> 1 2 3 \nGot: %v", output)
+	}
+}
+
+func TestPrintStackEmpty(t *testing.T) {
+	if output := captureOutput(".s"); output != "<0> " {
+		t.Fatalf("\nExpression: .s\nExpected: <0> \nGot: %v", output)
+	}
+}
+
+func TestDepth(t *testing.T) {
+	if passed, err := runTestLine("7 8 9 depth", variant.ForthInt(3), variant.ForthInt(9)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestClear(t *testing.T) {
+	if passed, err := runTestLine("1 2 3 clear depth", variant.ForthInt(0), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestPick(t *testing.T) {
+	if passed, err := runTestLine("10 20 30 2 pick", variant.ForthInt(10), variant.ForthInt(30), variant.ForthInt(20), variant.ForthInt(10)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestPickZeroIsDup(t *testing.T) {
+	if passed, err := runTestLine("10 20 0 pick", variant.ForthInt(20), variant.ForthInt(20), variant.ForthInt(10)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestRoll(t *testing.T) {
+	if passed, err := runTestLine("1 2 3 4 3 roll", variant.ForthInt(1), variant.ForthInt(4), variant.ForthInt(3), variant.ForthInt(2), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestRollTwoIsRot(t *testing.T) {
+	if passed, err := runTestLine("10 20 30 2 roll", variant.ForthInt(10), variant.ForthInt(30), variant.ForthInt(20)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestReverseRot(t *testing.T) {
+	if passed, err := runTestLine("10 20 30 -rot", variant.ForthInt(20), variant.ForthInt(10), variant.ForthInt(30)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestNip(t *testing.T) {
+	if passed, err := runTestLine("1 2 3 nip", variant.ForthInt(3), variant.ForthInt(1), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestTuck(t *testing.T) {
+	if passed, err := runTestLine("1 2 tuck", variant.ForthInt(2), variant.ForthInt(1), variant.ForthInt(2), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestDupIfTrue(t *testing.T) {
+	if passed, err := runTestLine("5 ?dup", variant.ForthInt(5), variant.ForthInt(5), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestDupIfFalse(t *testing.T) {
+	if passed, err := runTestLine("0 ?dup", variant.ForthInt(0), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestDup2(t *testing.T) {
+	if passed, err := runTestLine("1 2 2dup", variant.ForthInt(2), variant.ForthInt(1), variant.ForthInt(2), variant.ForthInt(1), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestDrop2(t *testing.T) {
+	if passed, err := runTestLine("1 2 3 2drop", variant.ForthInt(1), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestSwap2(t *testing.T) {
+	if passed, err := runTestLine("1 2 3 4 2swap", variant.ForthInt(2), variant.ForthInt(1), variant.ForthInt(4), variant.ForthInt(3), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestOver2(t *testing.T) {
+	if passed, err := runTestLine("1 2 3 4 2over", variant.ForthInt(2), variant.ForthInt(1), variant.ForthInt(4), variant.ForthInt(3), variant.ForthInt(2), variant.ForthInt(1), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestRot2(t *testing.T) {
+	if passed, err := runTestLine("1 2 3 4 5 6 2rot", variant.ForthInt(2), variant.ForthInt(1), variant.ForthInt(6), variant.ForthInt(5), variant.ForthInt(4), variant.ForthInt(3), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestUnrecognizedWord(t *testing.T) {
+	if passed, err := runTestError("1 2 frobnicate", variant.ErrUndefinedWord); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestStackUnderflow(t *testing.T) {
+	if passed, err := runTestError("1 +", variant.ErrStackUnderflow); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestInvalidOperands(t *testing.T) {
+	if passed, err := runTestError(`1 "a" +`, variant.ErrTypeMismatch); !passed {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,218 @@
+package tests
+
+import (
+	"errors"
+	"goforth/forth"
+	"goforth/variant"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestRegisterWord(t *testing.T) {
+	var program = forth.NewForthProgram()
+	var err = program.RegisterWord("sum3", 3, 1, func(args []variant.Variant) ([]variant.Variant, error) {
+		return []variant.Variant{args[0].Add(args[1]).Add(args[2])}, nil
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if passed, err := runProgramTestLine(program, "10 1 2 3 SUM3", variant.ForthInt(6), variant.ForthInt(10), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runProgramTestError(program, "1 2 sum3", variant.ErrStackUnderflow); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestRegisterWordArgumentOrder(t *testing.T) {
+	var program = forth.NewForthProgram()
+	program.RegisterWord("pair", 2, 2, func(args []variant.Variant) ([]variant.Variant, error) {
+		return []variant.Variant{args[1], args[0]}, nil
+	})
+
+	if passed, err := runProgramTestLine(program, `"a" "b" pair`, variant.ForthString("a"), variant.ForthString("b"), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestRegisterWordErrors(t *testing.T) {
+	var program = forth.NewForthProgram()
+	program.RegisterWord("fail", 0, 0, func(args []variant.Variant) ([]variant.Variant, error) {
+		return nil, errors.New("database is down")
+	})
+
+	program.RegisterWord("overflow", 0, 0, func(args []variant.Variant) ([]variant.Variant, error) {
+		return nil, variant.NewError(variant.ErrResultOutOfRange, "too big")
+	})
+
+	program.RegisterWord("liar", 0, 1, func(args []variant.Variant) ([]variant.Variant, error) {
+		return nil, nil
+	})
+
+	var tests = map[string]variant.ErrorCode{
+		"fail":     variant.ErrAbort,
+		"overflow": variant.ErrResultOutOfRange,
+		"liar":     variant.ErrAbort,
+	}
+
+	for line, code := range tests {
+		if passed, err := runProgramTestError(program, line, code); !passed {
+			t.Fatal(err)
+		}
+	}
+
+	if passed, err := runProgramTestLine(program, "' fail catch", variant.ForthInt(variant.ErrAbort), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestRegisterWordNilResults(t *testing.T) {
+	var program = forth.NewForthProgram()
+	program.RegisterWord("hole", 0, 1, func(args []variant.Variant) ([]variant.Variant, error) {
+		return []variant.Variant{nil}, nil
+	})
+
+	if passed, err := runProgramTestLine(program, "hole hole nil?", variant.ForthBool(true), variant.ForthNil{}, nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runProgramTestError(program, "hole 1 +", variant.ErrTypeMismatch); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestRegisterWordRejectsBadNames(t *testing.T) {
+	var program = forth.NewForthProgram()
+	var noop = func(args []variant.Variant) ([]variant.Variant, error) { return nil, nil }
+	if err := program.RegisterWord("mine", 0, 0, noop); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"", "two words", "DUP", "+", "not", "MINE"} {
+		if err := program.RegisterWord(name, 0, 0, noop); err == nil {
+			t.Fatalf("Expected registering %q to fail", name)
+		}
+	}
+
+	if err := program.RegisterWord("negative", -1, 0, noop); err == nil {
+		t.Fatal("Expected a negative stack effect to fail")
+	}
+}
+
+func TestHostWordsArePerProgram(t *testing.T) {
+	var program = forth.NewForthProgram()
+	program.RegisterFunc("answer", func() int64 { return 42 })
+
+	if passed, err := runProgramTestLine(program, "answer", variant.ForthInt(42), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestError("answer", variant.ErrUndefinedWord); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestHostWordsAndDefinitionsDontShadow(t *testing.T) {
+	var program = forth.NewForthProgram()
+	program.RegisterFunc("answer", func() int64 { return 42 })
+	for _, line := range []string{": answer 7 ;", ": ANSWER 7 ;", "fvariable answer"} {
+		if passed, err := runProgramTestError(program, line, variant.ErrHostWordRedefined); !passed {
+			t.Fatal(err)
+		}
+	}
+
+	forth.ExecuteWordLine(program, ": Answer")
+	if passed, err := runProgramTestError(program, "7 ;", variant.ErrHostWordRedefined); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runProgramTestLine(program, "answer", variant.ForthInt(42), nil); !passed {
+		t.Fatal(err)
+	}
+
+	forth.ExecuteWordLine(program, ": Question 6 ;")
+	if err := program.RegisterFunc("question", func() int64 { return 0 }); err == nil {
+		t.Fatal("Expected registering a host word over a definition to fail")
+	}
+}
+
+func TestRegisterFuncIntegerRange(t *testing.T) {
+	var program = forth.NewForthProgram()
+	program.RegisterFunc("small", func(value int8) int8 { return value })
+	for _, line := range []string{"200 small", "99999999999999999999 small"} {
+		if passed, err := runProgramTestError(program, line, variant.ErrResultOutOfRange); !passed {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRegisterFunc(t *testing.T) {
+	var program = forth.NewForthProgram()
+	var functions = map[string]any{
+		"parse-base": func(base int64, text string) (float64, error) {
+			var value, err = strconv.ParseInt(text, int(base), 64)
+			return float64(value), err
+		},
+		"repeat":  strings.Repeat,
+		"hypot":   math.Hypot,
+		"small":   func(value int8) int8 { return value },
+		"counter": func(value uint64) uint64 { return value + math.MaxInt64 },
+		"flip":    func(value bool) bool { return !value },
+		"first":   func(list variant.ForthList) variant.Variant { return list[0] },
+		"nothing": func() variant.Variant { return nil },
+	}
+
+	for name, function := range functions {
+		if err := program.RegisterFunc(name, function); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if passed, err := runProgramTestLine(program, `16 "ff" parse-base "ab" 3 repeat 3 4 hypot 3/1r 4 hypot`, variant.ForthFloat(5), variant.ForthFloat(5), variant.ForthString("ababab"), variant.ForthFloat(255), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runProgramTestLine(program, `-128 small 2 counter true flip { "x" 2 } first nothing`, variant.ForthNil{}, variant.ForthString("x"), variant.ForthBool(false), bigInt("9223372036854775809"), variant.ForthInt(-128), nil); !passed {
+		t.Fatal(err)
+	}
+
+	var tests = map[string]variant.ErrorCode{
+		`10 "zz" parse-base`:   variant.ErrAbort,
+		`"16" "ff" parse-base`: variant.ErrTypeMismatch,
+		`"ab" 1.5 repeat`:      variant.ErrTypeMismatch,
+		`"a" 1 hypot`:          variant.ErrTypeMismatch,
+		"128 small":            variant.ErrResultOutOfRange,
+		"-1 counter":           variant.ErrResultOutOfRange,
+		"1 flip":               variant.ErrTypeMismatch,
+		"5 first":              variant.ErrTypeMismatch,
+		"hypot":                variant.ErrStackUnderflow,
+	}
+
+	for line, code := range tests {
+		if passed, err := runProgramTestError(program, line, code); !passed {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRegisterFuncRejectsUnsupportedSignatures(t *testing.T) {
+	var program = forth.NewForthProgram()
+	var tests = map[string]any{
+		"not-a-func": 5,
+		"nil-func":   (func())(nil),
+		"variadic":   func(values ...int64) {},
+		"channel":    func(values chan int) {},
+		"map-result": func() map[string]int { return nil },
+	}
+
+	for name, function := range tests {
+		if err := program.RegisterFunc(name, function); err == nil {
+			t.Fatalf("Expected registering %s to fail", name)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package tests
+
+import (
+	"context"
+	"goforth/forth"
+	"goforth/variant"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeSources(t *testing.T, files map[string]string) string {
+	t.Helper()
+	var dir = t.TempDir()
+	for name, contents := range files {
+		var path = filepath.Join(dir, name)
+		os.MkdirAll(filepath.Dir(path), 0o755)
+		if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestIncludeResolvesRelativePaths(t *testing.T) {
+	var dir = writeSources(t, map[string]string{
+		"main.fth":       "#!/usr/bin/env goforth\ninclude lib/math.fth\n3 cube",
+		"lib/math.fth":   "include square.fth\n: cube\n  dup square * ;",
+		"lib/square.fth": ": square dup * ;",
+	})
+
+	var program = forth.NewForthProgram()
+	if err := program.ExecuteFile(context.Background(), filepath.Join(dir, "main.fth")); err != nil {
+		t.Fatal(err)
+	}
+
+	if passed, err := runProgramTestLine(program, "", variant.ForthInt(27)); !passed {
+		t.Fatal(err)
+	}
+
+	var line = `"` + filepath.Join(dir, "lib", "square.fth") + `" included 4 square`
+	if passed, err := runProgramTestLine(program, line, variant.ForthInt(16)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestRequireLoadsOnce(t *testing.T) {
+	var dir = writeSources(t, map[string]string{
+		"main.fth":    "require counter.fth\nrequire ./counter.fth\n\"counter.fth\" required\ninclude counter.fth",
+		"counter.fth": "1 +",
+	})
+
+	var program = forth.NewForthProgram()
+	if passed, err := runProgramTestLine(program, "0"); !passed {
+		t.Fatal(err)
+	}
+
+	if err := program.ExecuteFile(context.Background(), filepath.Join(dir, "main.fth")); err != nil {
+		t.Fatal(err)
+	}
+
+	if passed, err := runProgramTestLine(program, "require "+filepath.Join(dir, "counter.fth"), variant.ForthInt(2)); !passed {
+		t.Fatal(err)
+	}
+
+	program.Reset()
+	if passed, err := runProgramTestLine(program, "0 require "+filepath.Join(dir, "counter.fth"), variant.ForthInt(1)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestIncludeCycle(t *testing.T) {
+	var dir = writeSources(t, map[string]string{
+		"a.fth": "include b.fth",
+		"b.fth": "1\ninclude a.fth",
+	})
+
+	var program = forth.NewForthProgram()
+	var err = program.ExecuteFile(context.Background(), filepath.Join(dir, "a.fth"))
+	expectErrorCode(t, err, variant.ErrIncludeCycle)
+
+	var a, b = filepath.Join(dir, "a.fth"), filepath.Join(dir, "b.fth")
+	var expected = "Include cycle: " + a + " -> " + b + " -> " + a + "\n  at " + b + ":2\n  included from " + a + ":1"
+	if err.Error() != expected {
+		t.Fatalf("Expected %q, got %q", expected, err.Error())
+	}
+}
+
+func TestIncludeErrorChain(t *testing.T) {
+	var dir = writeSources(t, map[string]string{
+		"main.fth":       "1 2\ninclude lib/outer.fth",
+		"lib/outer.fth":  "\n\nrequire inner.fth",
+		"lib/inner.fth":  ": ok 1 ;\n0 0 /",
+		"lib/caught.fth": "\"inner.fth\" ' included catch",
+	})
+
+	var program = forth.NewForthProgram()
+	var err = program.ExecuteFile(context.Background(), filepath.Join(dir, "main.fth"))
+	expectErrorCode(t, err, variant.ErrDivisionByZero)
+
+	var chain = strings.Split(err.Error(), "\n")[1:]
+	var expected = []string{
+		"  at " + filepath.Join(dir, "lib", "inner.fth") + ":2",
+		"  included from " + filepath.Join(dir, "lib", "outer.fth") + ":3",
+		"  included from " + filepath.Join(dir, "main.fth") + ":2",
+	}
+	if strings.Join(chain, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("Expected chain %q, got %q", expected, chain)
+	}
+
+	if err := program.ExecuteFile(context.Background(), filepath.Join(dir, "lib", "caught.fth")); err != nil {
+		t.Fatal(err)
+	}
+
+	if passed, err := runProgramTestLine(program, "", variant.ForthInt(int(variant.ErrDivisionByZero))); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestIncludeMissingFile(t *testing.T) {
+	if passed, err := runTestError("include "+filepath.Join(t.TempDir(), "missing.fth"), variant.ErrNonExistentFile); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestError("include", variant.ErrZeroLengthName); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestError("1 included", variant.ErrTypeMismatch); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestIncludeNeedsCapability(t *testing.T) {
+	var dir = writeSources(t, map[string]string{"lib.fth": "1"})
+	var path = filepath.Join(dir, "lib.fth")
+	var sandbox = forth.NewForthProgram(forth.WithCapabilities(forth.AllCapabilities &^ forth.CapabilityFS))
+	for _, line := range []string{"include " + path, `"` + path + `" included`, "require " + path, `"` + path + `" required`} {
+		if passed, err := runProgramTestError(sandbox, line, variant.ErrWordNotPermitted); !passed {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestCatchingIncludeKeepsIncluderState(t *testing.T) {
+	var dir = writeSources(t, map[string]string{"bad.fth": "1 2\nbogus"})
+	var program = forth.NewForthProgram()
+	var line = `3 0 do "` + filepath.Join(dir, "bad.fth") + `" ' included catch 2drop i loop`
+	if passed, err := runProgramTestLine(program, line, variant.ForthInt(2), variant.ForthInt(1), variant.ForthInt(0)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestUnterminatedDefinition(t *testing.T) {
+	var dir = writeSources(t, map[string]string{
+		"open.fth":     ": x\n  1 2",
+		"includer.fth": "include open.fth\n5",
+	})
+
+	var program = forth.NewForthProgram()
+	var err = program.ExecuteFile(context.Background(), filepath.Join(dir, "open.fth"))
+	expectErrorCode(t, err, variant.ErrControlMismatch)
+	if expected := "Unterminated definition of 'x' at the end of " + filepath.Join(dir, "open.fth"); !strings.HasPrefix(err.Error(), expected) {
+		t.Fatalf("Expected %q, got %q", expected, err.Error())
+	}
+
+	if program.IsCompiling() {
+		t.Fatal("Expected the open definition to be closed")
+	}
+
+	err = program.ExecuteFile(context.Background(), filepath.Join(dir, "includer.fth"))
+	expectErrorCode(t, err, variant.ErrControlMismatch)
+	if !strings.HasSuffix(err.Error(), "included from "+filepath.Join(dir, "includer.fth")+":1") {
+		t.Fatalf("Expected the include chain in %q", err.Error())
+	}
+
+	expectErrorCode(t, program.ExecuteContext(context.Background(), ": y\n1"), variant.ErrControlMismatch)
+	if passed, err := runProgramTestLine(program, "clear 3 dup *", variant.ForthInt(9)); !passed || program.IsCompiling() {
+		t.Fatal(err)
+	}
+
+	if err := program.ExecuteLineContext(context.Background(), ": z"); err != nil || !program.IsCompiling() {
+		t.Fatalf("Expected a single interactive line to leave the definition open, got %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package tests
+
+import (
+	"bufio"
+	"goforth/forth"
+	"goforth/variant"
+	"strings"
+	"testing"
+)
+
+func TestStdoutOption(t *testing.T) {
+	var output strings.Builder
+	var program = forth.NewForthProgram(forth.WithStdout(&output))
+	if err := forth.ExecuteWordLine(program, `1 . 2 , 'x' emit 1.5 f. 3 4 .s`); err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := "12\nx1.5<2> 3 4 "; output.String() != expected {
+		t.Fatalf("\nExpected: %q\nGot: %q", expected, output.String())
+	}
+}
+
+func TestStderrOption(t *testing.T) {
+	var errors strings.Builder
+	var program = forth.NewForthProgram(forth.WithStderr(&errors))
+	if program.Stderr() != &errors {
+		t.Fatal("Stderr option was not applied")
+	}
+}
+
+func TestKey(t *testing.T) {
+	var program = forth.NewForthProgram(forth.WithStdin(strings.NewReader("hé")))
+	if passed, err := runProgramTestLine(program, "key key key", variant.ForthNil{}, variant.ForthChar('é'), variant.ForthChar('h'), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestAccept(t *testing.T) {
+	var program = forth.NewForthProgram(forth.WithStdin(strings.NewReader("first line\r\nsecond\nlast")))
+	if passed, err := runProgramTestLine(program, "accept accept accept accept", variant.ForthNil{}, variant.ForthString("last"), variant.ForthString("second"), variant.ForthString("first line"), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestSharedBufferedStdin(t *testing.T) {
+	var reader = bufio.NewReader(strings.NewReader("accept\nthe input\nkey\nz"))
+	var program = forth.NewForthProgram(forth.WithStdin(reader))
+	for range 2 {
+		var line, _ = reader.ReadString('\n')
+		if err := forth.ExecuteWordLine(program, line); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if passed, err := runProgramTestLine(program, "", variant.ForthChar('z'), variant.ForthString("the input"), nil); !passed {
+		t.Fatal(err)
+	}
+}
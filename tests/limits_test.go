@@ -0,0 +1,135 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"goforth/forth"
+	"goforth/variant"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func expectErrorCode(t *testing.T, err error, code variant.ErrorCode) {
+	t.Helper()
+	var forthError *variant.ForthError
+	if !errors.As(err, &forthError) || forthError.Code != code {
+		t.Fatalf("Expected error code %v, got %v", code, err)
+	}
+}
+
+func TestExecuteContextTimeout(t *testing.T) {
+	var source, _ = os.ReadFile("../examples/10_print.fth")
+	var program = forth.NewForthProgram(forth.WithStdout(io.Discard))
+	var ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	var err = program.ExecuteContext(ctx, string(source))
+	expectErrorCode(t, err, variant.ErrUserInterrupt)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the error to wrap context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestExecuteContextCancel(t *testing.T) {
+	var program = forth.NewForthProgram()
+	var ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	var err = program.ExecuteContext(ctx, ": spin begin 1 drop again ;\nspin")
+	expectErrorCode(t, err, variant.ErrUserInterrupt)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected the error to wrap context.Canceled, got %v", err)
+	}
+
+	var depth = program.Depth()
+	expectErrorCode(t, program.ExecuteContext(ctx, "1 2 +"), variant.ErrUserInterrupt)
+	if program.Depth() != depth {
+		t.Fatalf("Expected a cancelled context to run nothing, got %v", program.Snapshot())
+	}
+}
+
+func TestExecuteContextMultipleLines(t *testing.T) {
+	var program = forth.NewForthProgram()
+	if err := program.ExecuteContext(context.Background(), ": double 2 * ;\n\n21 double\n"); err != nil {
+		t.Fatal(err)
+	}
+
+	if passed, err := runProgramTestLine(program, "", variant.ForthInt(42), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestStepLimit(t *testing.T) {
+	var program = forth.NewForthProgram(forth.WithMaxSteps(1000))
+	expectErrorCode(t, forth.ExecuteWordLine(program, "begin again"), variant.ErrStepLimitExceeded)
+
+	for range 3 {
+		if err := forth.ExecuteWordLine(program, "0 100 0 do i + loop drop"); err != nil {
+			t.Fatalf("Expected each line to get a fresh step budget, got %v", err)
+		}
+	}
+
+	forth.ExecuteWordLine(program, ": forever begin again ;")
+	expectErrorCode(t, forth.ExecuteWordLine(program, "' forever catch"), variant.ErrStepLimitExceeded)
+	expectErrorCode(t, program.ExecuteContext(context.Background(), "0 300 0 do i + loop\n0 300 0 do i + loop"), variant.ErrStepLimitExceeded)
+}
+
+func TestStackDepthLimit(t *testing.T) {
+	var program = forth.NewForthProgram(forth.WithMaxStackDepth(100))
+	expectErrorCode(t, forth.ExecuteWordLine(program, "begin 1 again"), variant.ErrStackOverflow)
+
+	program.SetSeparateFloatStack(true)
+	forth.ExecuteWordLine(program, "clear")
+	expectErrorCode(t, forth.ExecuteWordLine(program, "begin 1.0 again"), variant.ErrStackOverflow)
+}
+
+func TestStackDepthLimitWithinOneWord(t *testing.T) {
+	var program = forth.NewForthProgram(forth.WithMaxStackDepth(100))
+	program.RegisterWord("flood", 0, 1000, func(args []variant.Variant) ([]variant.Variant, error) {
+		var results = make([]variant.Variant, 1000)
+		for i := range results {
+			results[i] = variant.ForthInt(i)
+		}
+
+		return results, nil
+	})
+
+	expectErrorCode(t, forth.ExecuteWordLine(program, "flood"), variant.ErrStackOverflow)
+	if program.Depth() > 100 {
+		t.Fatalf("Expected the stack to stop at 100 elements, got %d", program.Depth())
+	}
+
+	forth.ExecuteWordLine(program, "clear")
+	expectErrorCode(t, forth.ExecuteWordLine(program, "1 99 0 do dup loop tuck"), variant.ErrStackOverflow)
+
+	forth.ExecuteWordLine(program, "clear")
+	if passed, err := runProgramTestLine(program, "' flood catch depth", variant.ForthInt(1), variant.ForthInt(variant.ErrStackOverflow), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestMemoryLimit(t *testing.T) {
+	var program = forth.NewForthProgram(forth.WithMaxMemory(1 << 20))
+	expectErrorCode(t, forth.ExecuteWordLine(program, `"xx" begin dup + again`), variant.ErrMemoryLimitExceeded)
+
+	forth.ExecuteWordLine(program, "clear")
+	expectErrorCode(t, forth.ExecuteWordLine(program, `begin "many small strings" again`), variant.ErrMemoryLimitExceeded)
+
+	forth.ExecuteWordLine(program, "clear")
+	expectErrorCode(t, forth.ExecuteWordLine(program, `{ "xx" } begin dup concat again`), variant.ErrMemoryLimitExceeded)
+}
+
+func TestOutputLimit(t *testing.T) {
+	var output strings.Builder
+	var program = forth.NewForthProgram(forth.WithStdout(&output), forth.WithMaxOutput(10))
+	expectErrorCode(t, forth.ExecuteWordLine(program, `begin "abc" . again`), variant.ErrOutputLimitExceeded)
+	if output.String() != "abcabcabca" {
+		t.Fatalf("Expected output to stop at 10 bytes, got %q", output.String())
+	}
+}
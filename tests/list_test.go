@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"goforth/forth"
+	"goforth/variant"
+	"testing"
+)
+
+func TestListLiteral(t *testing.T) {
+	if passed, err := runTestLine("0 { 1 2 3 }", variant.ForthList{variant.ForthInt(1), variant.ForthInt(2), variant.ForthInt(3)}, variant.ForthInt(0), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestNestedList(t *testing.T) {
+	if passed, err := runTestLine(`{ 1 { "a" 2.5 } { } }`, variant.ForthList{variant.ForthInt(1), variant.ForthList{variant.ForthString("a"), variant.ForthFloat(2.5)}, variant.ForthList{}}, nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestListFormatting(t *testing.T) {
+	if output := captureOutput(`{ 1 { 2.5 3/4r } "x" } .`); output != "{ 1 { 2.5 3/4 } x }" {
+		t.Fatalf("\nExpression: { 1 { 2.5 3/4r } \"x\" } .\nExpected: { 1 { 2.5 3/4 } x }\nGot: %v", output)
+	}
+}
+
+func TestListLength(t *testing.T) {
+	if passed, err := runTestLine(`{ 1 2 3 } length { } length "héllo" length`, variant.ForthInt(5), variant.ForthInt(0), variant.ForthInt(3)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestListNth(t *testing.T) {
+	if passed, err := runTestLine("{ 10 20 30 } 1 nth", variant.ForthInt(20), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestListNthOutOfRange(t *testing.T) {
+	if passed, err := runTestError("{ 10 20 30 } 3 nth", variant.ErrInvalidNumericArgument); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestListSetNthCopies(t *testing.T) {
+	if passed, err := runTestLine("{ 1 2 3 } dup 0 9 set-nth", variant.ForthList{variant.ForthInt(9), variant.ForthInt(2), variant.ForthInt(3)}, variant.ForthList{variant.ForthInt(1), variant.ForthInt(2), variant.ForthInt(3)}); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestListAppendConcat(t *testing.T) {
+	if passed, err := runTestLine("{ 1 } 2 append { 3 } concat { 4 } +", variant.ForthList{variant.ForthInt(1), variant.ForthInt(2), variant.ForthInt(3), variant.ForthInt(4)}, nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestListSlice(t *testing.T) {
+	if passed, err := runTestLine("{ 1 2 3 4 5 } 1 4 slice", variant.ForthList{variant.ForthInt(2), variant.ForthInt(3), variant.ForthInt(4)}, nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestListForEach(t *testing.T) {
+	if output := captureOutput("{ 1 2 3 } ' . for-each"); output != "123" {
+		t.Fatalf("\nExpression: { 1 2 3 } ' . for-each\nExpected: 123\nGot: %v", output)
+	}
+}
+
+func TestListMapFilterReduce(t *testing.T) {
+	var program = forth.NewForthProgram()
+	forth.ExecuteWordLine(program, ": square dup * ;")
+	forth.ExecuteWordLine(program, ": odd? 2 % 1 == ;")
+	if passed, err := runProgramTestLine(program, "{ 1 2 3 4 } ' square map ' odd? filter 0 ' + reduce", variant.ForthInt(10), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestListWordsNeedOneResult(t *testing.T) {
+	var tests = map[string]variant.ErrorCode{
+		"{ 1 2 3 } ' dup map":        variant.ErrTypeMismatch,
+		"{ 1 2 3 } ' drop filter":    variant.ErrStackUnderflow,
+		"{ 1 2 3 } 0 ' 2drop reduce": variant.ErrStackUnderflow,
+		"{ 3 1 2 } ' 2dup sort":      variant.ErrTypeMismatch,
+	}
+
+	for line, code := range tests {
+		if passed, err := runTestError(line, code); !passed {
+			t.Fatal(err)
+		}
+	}
+
+	var program = forth.NewForthProgram()
+	forth.ExecuteWordLine(program, ": dups ' dup map ;")
+	if passed, err := runProgramTestLine(program, "7 { 1 2 } ' dups catch", variant.ForthInt(variant.ErrTypeMismatch), variant.ForthList{variant.ForthInt(1), variant.ForthInt(2)}, variant.ForthInt(7), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestListSortWithComparator(t *testing.T) {
+	if passed, err := runTestLine("{ 3 1 2 } ' > sort", variant.ForthList{variant.ForthInt(3), variant.ForthInt(2), variant.ForthInt(1)}, nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestListEquality(t *testing.T) {
+	if passed, err := runTestLine(`{ 1 "a" } { 1 "a" } == { 1 } { "1" } ==`, variant.ForthBool(false), variant.ForthBool(true)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestListMismatchedBrace(t *testing.T) {
+	if passed, err := runTestError("1 2 }", variant.ErrControlMismatch); !passed {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,116 @@
+package tests
+
+import (
+	"goforth/variant"
+	"testing"
+)
+
+func TestMapPutGet(t *testing.T) {
+	if passed, err := runTestLine(`new-map "host" "localhost" put 8080 "port" put dup "host" "none" get swap 8080 0 get`, variant.ForthString("port"), variant.ForthString("localhost"), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestMapGetDefault(t *testing.T) {
+	if passed, err := runTestLine(`new-map "missing" 42 get`, variant.ForthInt(42), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestMapHasDelete(t *testing.T) {
+	if passed, err := runTestLine(`new-map "a" 1 put "b" 2 put "a" delete dup "a" has swap "b" has`, variant.ForthBool(true), variant.ForthBool(false), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestMapSize(t *testing.T) {
+	if passed, err := runTestLine(`new-map "a" 1 put "a" 2 put 3 "c" put size`, variant.ForthInt(2), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestMapKeysValuesInInsertionOrder(t *testing.T) {
+	if passed, err := runTestLine(`new-map "z" 1 put "a" 2 put "m" 3 put "z" 4 put dup keys swap values`,
+		variant.ForthList{variant.ForthInt(4), variant.ForthInt(2), variant.ForthInt(3)},
+		variant.ForthList{variant.ForthString("z"), variant.ForthString("a"), variant.ForthString("m")}, nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestMapFormatting(t *testing.T) {
+	if output := captureOutput(`new-map "b" 1 put "a" { 2 3 } put .`); output != "#{ b: 1 a: { 2 3 } }" {
+		t.Fatalf("\nExpression: new-map \"b\" 1 put \"a\" { 2 3 } put .\nExpected: #{ b: 1 a: { 2 3 } }\nGot: %v", output)
+	}
+}
+
+func TestMapIsImmutable(t *testing.T) {
+	if passed, err := runTestLine(`new-map dup "a" 1 put drop size`, variant.ForthInt(0), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestMapEquality(t *testing.T) {
+	if passed, err := runTestLine(`new-map "a" 1 put "b" 2 put new-map "b" 2 put "a" 1 put == new-map "a" 1 put new-map "a" 2 put ==`, variant.ForthBool(false), variant.ForthBool(true), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestMapInvalidKey(t *testing.T) {
+	if passed, err := runTestError(`new-map { 1 } 2 put`, variant.ErrTypeMismatch); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestMapKeysMatchWithEq(t *testing.T) {
+	if passed, err := runTestLine(`new-map 1 "a" put 1.0 lookup`, variant.ForthString("a"), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestLine(`new-map 1/2r "half" put 0.5 "x" put dup size swap 0.5 lookup`, variant.ForthString("x"), variant.ForthInt(1), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestLine(`new-map 1.5d "dec" put 3/2r lookup new-map 100000000000000000000 "big" put 100000000000000000000 lookup`, variant.ForthString("big"), variant.ForthString("dec"), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestMapAcceptsRegisteredTypeKeys(t *testing.T) {
+	var program = newMoneyProgram(t)
+	if passed, err := runProgramTestLine(program, `new-map $1.50 "price" put 150 "int" put dup $1.50 lookup swap size`, variant.ForthInt(2), variant.ForthString("price"), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestMapRejectsNaNKeys(t *testing.T) {
+	if passed, err := runTestError(`new-map 0.0 0.0 / 1 put`, variant.ErrTypeMismatch); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestMapBranchesStayIndependent(t *testing.T) {
+	var line = `new-map "a" 1 put dup "b" 2 put swap "c" 3 put "a" delete "a" 4 put dup keys swap values rot keys`
+	var expected = []variant.Variant{
+		variant.ForthList{variant.ForthString("a"), variant.ForthString("b")},
+		variant.ForthList{variant.ForthInt(3), variant.ForthInt(4)},
+		variant.ForthList{variant.ForthString("c"), variant.ForthString("a")},
+	}
+
+	if passed, err := runTestLine(line, expected...); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestMapBigIntKeysStayDistinct(t *testing.T) {
+	var line = `new-map 99999999999999999999 "a" put 99999999999999999998 "b" put 9007199254740993 "c" put 9007199254740992 "d" put ` +
+		`dup size over 99999999999999999999 lookup rot 9007199254740993 lookup`
+	var expected = []variant.Variant{
+		variant.ForthString("c"),
+		variant.ForthString("a"),
+		variant.ForthInt(4),
+	}
+
+	if passed, err := runTestLine(line, expected...); !passed {
+		t.Fatal(err)
+	}
+}
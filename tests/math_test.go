@@ -0,0 +1,157 @@
+package tests
+
+import (
+	"goforth/forth"
+	"goforth/variant"
+	"math"
+	"testing"
+)
+
+func TestAbs(t *testing.T) {
+	if passed, err := runTestLine("-5 abs -2.5 abs", variant.ForthFloat(2.5), variant.ForthInt(5)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestAbsInvalidOperand(t *testing.T) {
+	if passed, err := runTestError(`"five" abs`, variant.ErrTypeMismatch); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestNegate(t *testing.T) {
+	if passed, err := runTestLine("5 negate 1.5 negate", variant.ForthFloat(-1.5), variant.ForthInt(-5)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestNegateOutOfRange(t *testing.T) {
+	if passed, err := runTestError("-9223372036854775808 negate", variant.ErrResultOutOfRange); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	if passed, err := runTestLine("3 7 min 3 7 max 2.5 2 max", variant.ForthFloat(2.5), variant.ForthInt(7), variant.ForthInt(3)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestIncrementDecrement(t *testing.T) {
+	if passed, err := runTestLine("5 1+ 5 1- 0.5 1+", variant.ForthFloat(1.5), variant.ForthInt(4), variant.ForthInt(6)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestDoubleHalve(t *testing.T) {
+	if passed, err := runTestLine("5 2* -7 2/ 3.0 2/", variant.ForthFloat(1.5), variant.ForthInt(-4), variant.ForthInt(10)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestShifts(t *testing.T) {
+	if passed, err := runTestLine("1 4 lshift -1 60 rshift", variant.ForthInt(15), variant.ForthInt(16)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestShiftInvalidOperand(t *testing.T) {
+	if passed, err := runTestError("1.5 2 lshift", variant.ErrTypeMismatch); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestDivideMod(t *testing.T) {
+	if passed, err := runTestLine("17 5 /mod", variant.ForthInt(3), variant.ForthInt(2)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestScale(t *testing.T) {
+	if passed, err := runTestLine("9223372036854775807 4 8 */", variant.ForthInt(4611686018427387903)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestScaleMod(t *testing.T) {
+	if passed, err := runTestLine("10 7 3 */mod", variant.ForthInt(23), variant.ForthInt(1)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestScaleOutOfRange(t *testing.T) {
+	if passed, err := runTestError("9223372036854775807 4 2 */", variant.ErrResultOutOfRange); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestDivideModOutOfRangeLeavesNoResult(t *testing.T) {
+	for _, line := range []string{"-9223372036854775808 -1 /mod", "-9223372036854775808 1 -1 */mod"} {
+		var program = forth.NewForthProgram()
+		if passed, err := runProgramTestError(program, line, variant.ErrResultOutOfRange); !passed {
+			t.Fatal(err)
+		}
+
+		if program.Depth() != 0 {
+			t.Fatalf("Expected %q to leave an empty stack, got %v", line, program.Snapshot())
+		}
+	}
+
+	if passed, err := runTestLine("7 -9223372036854775808 -1 ' /mod catch", variant.ForthInt(variant.ErrResultOutOfRange), variant.ForthInt(-1), variant.ForthInt(-9223372036854775808), variant.ForthInt(7), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestScaleDivisionByZero(t *testing.T) {
+	if passed, err := runTestError("1 2 0 */", variant.ErrDivisionByZero); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFloatFunctions(t *testing.T) {
+	if passed, err := runTestLine("16 fsqrt 0 fcos 1 fexp fln 100 flog 2 10 f**", variant.ForthFloat(1024), variant.ForthFloat(2), variant.ForthFloat(1), variant.ForthFloat(1), variant.ForthFloat(4)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFloatTrigonometry(t *testing.T) {
+	if passed, err := runTestLine("1 1 fatan2 pi 4.0 f/ f-", variant.ForthFloat(0)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFloatRounding(t *testing.T) {
+	if passed, err := runTestLine("-2.5 floor 2.5 fround 3.5 fround -2.7 ftrunc", variant.ForthFloat(-2), variant.ForthFloat(4), variant.ForthFloat(2), variant.ForthFloat(-3)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFloatInvalidArgument(t *testing.T) {
+	if passed, err := runTestError("-1.0 fsqrt", variant.ErrInvalidFloatArgument); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFloatFunctionOnString(t *testing.T) {
+	if passed, err := runTestError(`"x" fsin`, variant.ErrTypeMismatch); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestPi(t *testing.T) {
+	if passed, err := runTestLine("pi", variant.ForthFloat(math.Pi)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestIntFloatConversion(t *testing.T) {
+	if passed, err := runTestLine("3 s>f -2.9 f>s", variant.ForthInt(-2), variant.ForthFloat(3)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFloatToIntOutOfRange(t *testing.T) {
+	if passed, err := runTestError("1e300 f>s", variant.ErrResultOutOfRange); !passed {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"goforth/variant"
+	"testing"
+)
+
+func TestNilLiteral(t *testing.T) {
+	if passed, err := runTestLine("nil", variant.ForthNil{}, nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestNilEquality(t *testing.T) {
+	if passed, err := runTestLine(`nil nil == nil 0 == 0 nil == "" nil != nil false ==`, variant.ForthBool(false), variant.ForthBool(true), variant.ForthBool(false), variant.ForthBool(false), variant.ForthBool(true)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestNilIsFalsy(t *testing.T) {
+	if passed, err := runTestLine(`nil if "yes" else "no" then`, variant.ForthString("no"), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestNilPredicate(t *testing.T) {
+	if passed, err := runTestLine("nil nil? 0 nil? false nil?", variant.ForthBool(false), variant.ForthBool(false), variant.ForthBool(true)); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestNilArithmeticIsAnError(t *testing.T) {
+	if passed, err := runTestError("nil 1 +", variant.ErrTypeMismatch); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestError("1 nil +", variant.ErrTypeMismatch); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestMapLookupMissingIsNil(t *testing.T) {
+	if passed, err := runTestLine(`new-map "a" 1 put dup "a" lookup swap "b" lookup`, variant.ForthNil{}, variant.ForthInt(1), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFindSubstring(t *testing.T) {
+	if passed, err := runTestLine(`"héllo world" "world" find "hello" "xyz" find`, variant.ForthNil{}, variant.ForthInt(6), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestFindInList(t *testing.T) {
+	if passed, err := runTestLine(`{ 1 "a" nil } nil find { 1 2 } 3 find`, variant.ForthNil{}, variant.ForthInt(2), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestNilInList(t *testing.T) {
+	if output := captureOutput("{ 1 nil } ."); output != "{ 1 nil }" {
+		t.Fatalf("\nExpression: { 1 nil } .\nExpected: { 1 nil }\nGot: %v", output)
+	}
+}
@@ -0,0 +1,104 @@
+package tests
+
+import (
+	"goforth/variant"
+	"testing"
+)
+
+var promotionOperands = [...]string{
+	"true true", "true 2", "true 0.5",
+	"3 true", "3 2", "3 0.5",
+	"1.5 true", "1.5 2", "1.5 0.5",
+}
+
+const typeMismatch = variant.ErrTypeMismatch
+
+var promotionMatrix = map[string][len(promotionOperands)]any{
+	"+":   {variant.ForthInt(2), variant.ForthInt(3), variant.ForthFloat(1.5), variant.ForthInt(4), variant.ForthInt(5), variant.ForthFloat(3.5), variant.ForthFloat(2.5), variant.ForthFloat(3.5), variant.ForthFloat(2)},
+	"-":   {variant.ForthInt(0), variant.ForthInt(-1), variant.ForthFloat(0.5), variant.ForthInt(2), variant.ForthInt(1), variant.ForthFloat(2.5), variant.ForthFloat(0.5), variant.ForthFloat(-0.5), variant.ForthFloat(1)},
+	"*":   {variant.ForthInt(1), variant.ForthInt(2), variant.ForthFloat(0.5), variant.ForthInt(3), variant.ForthInt(6), variant.ForthFloat(1.5), variant.ForthFloat(1.5), variant.ForthFloat(3), variant.ForthFloat(0.75)},
+	"/":   {variant.ForthInt(1), variant.ForthInt(0), variant.ForthFloat(2), variant.ForthInt(3), variant.ForthInt(1), variant.ForthFloat(6), variant.ForthFloat(1.5), variant.ForthFloat(0.75), variant.ForthFloat(3)},
+	"%":   {variant.ForthInt(0), variant.ForthInt(1), variant.ForthFloat(0), variant.ForthInt(0), variant.ForthInt(1), variant.ForthFloat(0), variant.ForthFloat(0.5), variant.ForthFloat(1.5), variant.ForthFloat(0)},
+	"and": {variant.ForthBool(true), variant.ForthInt(0), typeMismatch, variant.ForthInt(1), variant.ForthInt(2), typeMismatch, typeMismatch, typeMismatch, typeMismatch},
+	"or":  {variant.ForthBool(true), variant.ForthInt(3), typeMismatch, variant.ForthInt(3), variant.ForthInt(3), typeMismatch, typeMismatch, typeMismatch, typeMismatch},
+	"xor": {variant.ForthBool(false), variant.ForthInt(3), typeMismatch, variant.ForthInt(2), variant.ForthInt(1), typeMismatch, typeMismatch, typeMismatch, typeMismatch},
+	"==":  {variant.ForthBool(true), variant.ForthBool(false), variant.ForthBool(false), variant.ForthBool(false), variant.ForthBool(false), variant.ForthBool(false), variant.ForthBool(false), variant.ForthBool(false), variant.ForthBool(false)},
+	"!=":  {variant.ForthBool(false), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true)},
+	"<":   {variant.ForthBool(false), variant.ForthBool(true), variant.ForthBool(false), variant.ForthBool(false), variant.ForthBool(false), variant.ForthBool(false), variant.ForthBool(false), variant.ForthBool(true), variant.ForthBool(false)},
+	">":   {variant.ForthBool(false), variant.ForthBool(false), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(false), variant.ForthBool(true)},
+	"<=":  {variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(false), variant.ForthBool(false), variant.ForthBool(false), variant.ForthBool(false), variant.ForthBool(false), variant.ForthBool(true), variant.ForthBool(false)},
+	">=":  {variant.ForthBool(true), variant.ForthBool(false), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(true), variant.ForthBool(false), variant.ForthBool(true)},
+}
+
+func TestPromotionMatrix(t *testing.T) {
+	for operator, results := range promotionMatrix {
+		for index, operands := range promotionOperands {
+			var line = operands + " " + operator
+			var passed bool
+			var err string
+			if code, isError := results[index].(variant.ErrorCode); isError {
+				passed, err = runTestError(line, code)
+			} else {
+				passed, err = runTestLine(line, results[index].(variant.Variant), nil)
+			}
+
+			if !passed {
+				t.Error(err)
+			}
+		}
+	}
+}
+
+func TestMixedEqualityIsSymmetric(t *testing.T) {
+	var tests = map[string]variant.ForthBool{
+		"1 1.5 ==":     false,
+		"1.5 1 ==":     false,
+		"1 1.0 ==":     true,
+		"1.0 1 ==":     true,
+		"1 1.5 !=":     true,
+		"1.5 1 !=":     true,
+		"true 1 ==":    true,
+		"1 true ==":    true,
+		"true 5 ==":    false,
+		"1.0 true ==":  true,
+		"false 0.0 ==": true,
+		"1 1.5 <":      true,
+		"1.5 1 >":      true,
+		"2 1.5 <=":     false,
+	}
+
+	for line, expected := range tests {
+		if passed, err := runTestLine(line, expected, nil); !passed {
+			t.Error(err)
+		}
+	}
+}
+
+func TestBoolDivisionByZero(t *testing.T) {
+	if passed, err := runTestError("true false /", variant.ErrDivisionByZero); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestError("3 false %", variant.ErrDivisionByZero); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestPromotionOutsideTable(t *testing.T) {
+	var tests = []string{`true "a" +`, `"a" true +`, "1.5 2i and", "true 1/2r +", "true 'a' <"}
+	for _, line := range tests {
+		if passed, err := runTestError(line, typeMismatch); !passed {
+			t.Error(err)
+		}
+	}
+}
+
+func TestNotByRank(t *testing.T) {
+	if passed, err := runTestLine("true not 5 not", variant.ForthInt(-6), variant.ForthBool(false), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestError("1.5 not", typeMismatch); !passed {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,170 @@
+package tests
+
+import (
+	"context"
+	"goforth/forth"
+	"goforth/variant"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func drawRandom(t *testing.T, program *forth.ForthProgram, line string) []variant.Variant {
+	t.Helper()
+	if err := forth.ExecuteWordLine(program, "clear "+line); err != nil {
+		t.Fatal(err)
+	}
+
+	return program.Snapshot()
+}
+
+func TestSeedOptionIsReproducible(t *testing.T) {
+	var line = "rand rand randf 1 100 rand-range 0.0 1.0 randf-range"
+	var first = drawRandom(t, forth.NewForthProgram(forth.WithSeed(42)), line)
+	var second = drawRandom(t, forth.NewForthProgram(forth.WithSeed(42)), line)
+	if !reflect.DeepEqual(first, second) {
+		t.Fatalf("Expected identical sequences for the same seed, got %v and %v", first, second)
+	}
+
+	var other = drawRandom(t, forth.NewForthProgram(forth.WithSeed(43)), line)
+	if reflect.DeepEqual(first, other) {
+		t.Fatalf("Expected different sequences for different seeds, got %v twice", first)
+	}
+}
+
+func TestSeedWord(t *testing.T) {
+	var program = forth.NewForthProgram()
+	var first = drawRandom(t, program, "7 seed rand rand randf")
+	var second = drawRandom(t, program, "7 seed rand rand randf")
+	if !reflect.DeepEqual(first, second) {
+		t.Fatalf("Expected 'seed' to restart the sequence, got %v and %v", first, second)
+	}
+
+	var seeded = drawRandom(t, forth.NewForthProgram(forth.WithSeed(7)), "rand rand randf")
+	if !reflect.DeepEqual(first, seeded) {
+		t.Fatalf("Expected 'seed' to match WithSeed, got %v and %v", first, seeded)
+	}
+}
+
+func TestRandomRange(t *testing.T) {
+	var program = forth.NewForthProgram(forth.WithSeed(1))
+	for range 200 {
+		var values = drawRandom(t, program, "-3 4 rand-range 5 6 rand-range")
+		var ranged, single = values[0].(variant.ForthInt), values[1].(variant.ForthInt)
+		if single != 5 || ranged < -3 || ranged >= 4 {
+			t.Fatalf("Expected 5 and a value in [-3, 4), got %v", values)
+		}
+	}
+
+	for _, line := range []string{"3 3 rand-range", "4 3 rand-range"} {
+		if passed, err := runProgramTestError(program, line, variant.ErrInvalidNumericArgument); !passed {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestRandomFloatRange(t *testing.T) {
+	var program = forth.NewForthProgram(forth.WithSeed(1))
+	for range 200 {
+		var value = drawRandom(t, program, "-1.5 2.5 randf-range")[0].(variant.ForthFloat)
+		if value < -1.5 || value >= 2.5 {
+			t.Fatalf("Expected a value in [-1.5, 2.5), got %v", value)
+		}
+	}
+
+	if passed, err := runProgramTestError(program, "2.0 1.0 randf-range", variant.ErrInvalidFloatArgument); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestShuffle(t *testing.T) {
+	var line = "{ 1 2 3 4 5 6 7 8 } dup shuffle"
+	var values = drawRandom(t, forth.NewForthProgram(forth.WithSeed(3)), line)
+	var original, shuffled = values[0].(variant.ForthList), values[1].(variant.ForthList)
+
+	var expected = variant.ForthList{}
+	for i := range 8 {
+		expected = append(expected, variant.ForthInt(i+1))
+	}
+
+	if !reflect.DeepEqual(original, expected) {
+		t.Fatalf("Expected 'shuffle' to leave the original list alone, got %v", original)
+	}
+
+	var sorted = slices.Clone(shuffled)
+	slices.SortFunc(sorted, func(a variant.Variant, b variant.Variant) int {
+		return int(a.(variant.ForthInt) - b.(variant.ForthInt))
+	})
+	if !reflect.DeepEqual(sorted, expected) {
+		t.Fatalf("Expected a permutation of %v, got %v", expected, shuffled)
+	}
+
+	var again = drawRandom(t, forth.NewForthProgram(forth.WithSeed(3)), line)
+	if !reflect.DeepEqual(values, again) {
+		t.Fatalf("Expected the same shuffle for the same seed, got %v and %v", values, again)
+	}
+}
+
+func TestChoice(t *testing.T) {
+	var program = forth.NewForthProgram(forth.WithSeed(5))
+	var members = []variant.Variant{variant.ForthString("a"), variant.ForthInt(2), variant.ForthChar('c')}
+	for range 50 {
+		var picked = drawRandom(t, program, `{ "a" 2 'c' } choice`)[0]
+		if !slices.Contains(members, picked) {
+			t.Fatalf("Expected one of %v, got %v", members, picked)
+		}
+	}
+
+	if passed, err := runProgramTestError(program, "{ } choice", variant.ErrInvalidNumericArgument); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestCloneContinuesRandomSequence(t *testing.T) {
+	var program = forth.NewForthProgram(forth.WithSeed(9))
+	drawRandom(t, program, "rand drop")
+	var clone = program.Clone()
+
+	var original = drawRandom(t, program, "rand randf")
+	var cloned = drawRandom(t, clone, "rand randf")
+	if !reflect.DeepEqual(original, cloned) {
+		t.Fatalf("Expected the clone to continue the same sequence, got %v and %v", original, cloned)
+	}
+
+	drawRandom(t, program, "rand rand rand")
+	var next = drawRandom(t, clone, "rand")
+	var reference = forth.NewForthProgram(forth.WithSeed(9))
+	if expected := drawRandom(t, reference, "rand rand randf rand")[3]; next[0] != expected {
+		t.Fatalf("Expected the clone's generator to be independent, got %v instead of %v", next[0], expected)
+	}
+}
+
+func TestSeededTenPrint(t *testing.T) {
+	var source, _ = os.ReadFile("../examples/10_print.fth")
+	var run = func() string {
+		var output strings.Builder
+		var program = forth.NewForthProgram(forth.WithStdout(&output), forth.WithSeed(10), forth.WithMaxOutput(200))
+		expectErrorCode(t, program.ExecuteContext(context.Background(), string(source)), variant.ErrOutputLimitExceeded)
+		return output.String()
+	}
+
+	var first, second = run(), run()
+	if first == "" || first != second {
+		t.Fatalf("Expected identical non-empty output for the same seed, got %q and %q", first, second)
+	}
+
+	if strings.Trim(first, `/\`) != "" {
+		t.Fatalf("Expected only '/' and '\\' characters, got %q", first)
+	}
+}
+
+func TestRandomNeedsCapability(t *testing.T) {
+	var sandbox = forth.NewForthProgram(forth.WithCapabilities(forth.AllCapabilities &^ forth.CapabilityRand))
+	for _, line := range []string{"rand", "randf", "1 seed", "0 3 rand-range", "0.0 1.0 randf-range", "{ 1 } shuffle", "{ 1 } choice"} {
+		if passed, err := runProgramTestError(sandbox, line, variant.ErrWordNotPermitted); !passed {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package tests
+
+import (
+	"bufio"
+	"errors"
+	"goforth/forth"
+	"goforth/repl"
+	"goforth/variant"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func editLine(t *testing.T, editor *repl.Editor, keys string) string {
+	t.Helper()
+	var line, err = editor.ReadLine("")
+	if err != nil {
+		t.Fatalf("Keys %q: unexpected error %v", keys, err)
+	}
+
+	return line
+}
+
+func newEditor(keys string, complete func(string) []string) *repl.Editor {
+	return repl.NewEditor(bufio.NewReader(strings.NewReader(keys)), io.Discard, complete)
+}
+
+func TestEditorEditing(t *testing.T) {
+	var tests = map[string]string{
+		"1 2 +\r":                             "1 2 +",
+		"abc\x1b[D\x1b[DX\r":                  "aXbc",
+		"abc\x01X\x05Y\r":                     "XabcY",
+		"abc\x7f\x7fd\n":                      "ad",
+		"abcd\x1b[H\x1b[3~\r":                 "bcd",
+		"abcd\x02\x02\x0b\r":                  "ab",
+		"abcd\x02\x02\x15\r":                  "cd",
+		"1 2 swap\x17over\r":                  "1 2 over",
+		"héllo\x1b[D\x1b[D\x1b[D\x1b[D\x08\r": "éllo",
+		"ab\x04\x02\x04\r":                    "a",
+	}
+
+	for keys, expected := range tests {
+		if line := editLine(t, newEditor(keys, nil), keys); line != expected {
+			t.Fatalf("Keys %q: expected %q, got %q", keys, expected, line)
+		}
+	}
+}
+
+func TestEditorInterruptAndEOF(t *testing.T) {
+	var editor = newEditor("abc\x03\x04", nil)
+	if _, err := editor.ReadLine(""); !errors.Is(err, repl.ErrInterrupted) {
+		t.Fatalf("Expected Ctrl-C to interrupt the line, got %v", err)
+	}
+
+	if _, err := editor.ReadLine(""); !errors.Is(err, io.EOF) {
+		t.Fatalf("Expected Ctrl-D on an empty line to return io.EOF, got %v", err)
+	}
+}
+
+func TestEditorHistory(t *testing.T) {
+	var editor = newEditor("\x1b[A\x1b[A\r\x1b[A\x1b[A\x1b[B\r3 4\x1b[A\x1b[B *\r", nil)
+	editor.AddHistory("1 2 +")
+	editor.AddHistory("3 4 *")
+	editor.AddHistory("3 4 *")
+	editor.AddHistory("  ")
+	if history := editor.History(); !slices.Equal(history, []string{"1 2 +", "3 4 *"}) {
+		t.Fatalf("Expected blank and repeated lines to be skipped, got %q", history)
+	}
+
+	for _, expected := range []string{"1 2 +", "3 4 *", "3 4 *"} {
+		if line := editLine(t, editor, "history"); line != expected {
+			t.Fatalf("Expected %q from history, got %q", expected, line)
+		}
+	}
+}
+
+func TestEditorCompletion(t *testing.T) {
+	var program = forth.NewForthProgram()
+	forth.ExecuteWordLine(program, ": square dup * ;")
+	forth.ExecuteWordLine(program, ": squares 0 do i square , loop ;")
+	forth.ExecuteWordLine(program, ": Cube dup dup * * ;")
+	forth.ExecuteWordLine(program, ": Quad square square ;")
+	forth.ExecuteWordLine(program, ": quads 0 do i Quad , loop ;")
+	var complete = repl.New(program).Complete
+
+	var tests = map[string]string{
+		"3 squ\t\r":     "3 square",
+		"3 squares\t\r": "3 squares ",
+		"5 ov\t\r":      "5 over ",
+		"1 DU\t\r":      "1 dup ",
+		"2 SWA\t\r":     "2 swap ",
+		"3 cu\t\r":      "3 Cube ",
+		"2 qua\t\r":     "2 Quad",
+		"zzz\t\r":       "zzz",
+	}
+
+	for keys, expected := range tests {
+		if line := editLine(t, newEditor(keys, complete), keys); line != expected {
+			t.Fatalf("Keys %q: expected %q, got %q", keys, expected, line)
+		}
+	}
+}
+
+func TestWordsListsDictionary(t *testing.T) {
+	var program = forth.NewForthProgram(forth.WithCapabilities(forth.AllCapabilities &^ forth.CapabilityFS))
+	forth.ExecuteWordLine(program, ": square dup * ;")
+	var words = program.Words()
+	if !slices.IsSorted(words) {
+		t.Fatalf("Expected sorted words, got %v", words)
+	}
+
+	for _, word := range []string{"square", "dup", "+", "not", "rand", ":"} {
+		if !slices.Contains(words, word) {
+			t.Fatalf("Expected %q in %v", word, words)
+		}
+	}
+
+	if slices.Contains(words, "read-file") {
+		t.Fatalf("Expected words the program can't run to be left out, got %v", words)
+	}
+}
+
+func TestMultiLineDefinition(t *testing.T) {
+	var program = forth.NewForthProgram()
+	for _, line := range []string{": cube", "dup dup", "* * ;"} {
+		if err := forth.ExecuteWordLine(program, line); err != nil {
+			t.Fatal(err)
+		}
+
+		if compiling := line != "* * ;"; program.IsCompiling() != compiling {
+			t.Fatalf("After %q expected compiling to be %v", line, compiling)
+		}
+	}
+
+	if passed, err := runProgramTestLine(program, "3 cube", variant.ForthInt(27)); !passed {
+		t.Fatal(err)
+	}
+
+	forth.ExecuteWordLine(program, ":")
+	if passed, err := runProgramTestError(program, ";", variant.ErrZeroLengthName); !passed {
+		t.Fatal(err)
+	}
+
+	if program.IsCompiling() {
+		t.Fatal("Expected a failed definition to leave compile state")
+	}
+}
+
+func TestByeIsNotCatchable(t *testing.T) {
+	if passed, err := runTestError("1 bye 2", variant.ErrBye); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestError("' bye catch", variant.ErrBye); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestREPLSession(t *testing.T) {
+	var historyPath = filepath.Join(t.TempDir(), "history")
+	os.WriteFile(historyPath, []byte("old line\n"), 0o600)
+
+	var stdout, stderr strings.Builder
+	var input = "1 2 +\n: sq\ndup * ;\n3 sq .\nbogus\n\"x\" .\nbye\n4 .\n"
+	var program = forth.NewForthProgram(forth.WithStdin(strings.NewReader(input)), forth.WithStdout(&stdout), forth.WithStderr(&stderr))
+	if err := repl.New(program, repl.WithHistoryFile(historyPath)).Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := " ok 1\n compiled\n ok 1\n9 ok 1\nx ok 1\n"; stdout.String() != expected {
+		t.Fatalf("Expected output %q, got %q", expected, stdout.String())
+	}
+
+	if expected := "Error: Unrecognized word 'bogus'\n"; stderr.String() != expected {
+		t.Fatalf("Expected errors %q, got %q", expected, stderr.String())
+	}
+
+	var history, _ = os.ReadFile(historyPath)
+	if expected := "old line\n1 2 +\n: sq\ndup * ;\n3 sq .\nbogus\n\"x\" .\nbye\n"; string(history) != expected {
+		t.Fatalf("Expected history %q, got %q", expected, history)
+	}
+}
+
+func TestREPLExitsOnEOF(t *testing.T) {
+	var stdout strings.Builder
+	var program = forth.NewForthProgram(forth.WithStdin(strings.NewReader("1 2 + .")), forth.WithStdout(&stdout))
+	if err := repl.New(program).Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	if stdout.String() != "3 ok\n" {
+		t.Fatalf("Expected %q, got %q", "3 ok\n", stdout.String())
+	}
+}
+
+func TestREPLInterruptsRunningLine(t *testing.T) {
+	var stdout, stderr strings.Builder
+	var started = make(chan struct{})
+	var interrupts = make(chan os.Signal)
+	var program = forth.NewForthProgram(forth.WithStdin(strings.NewReader("started begin again\n1 .\n")), forth.WithStdout(&stdout), forth.WithStderr(&stderr))
+	program.RegisterFunc("started", func() { close(started) })
+
+	go func() {
+		<-started
+		interrupts <- os.Interrupt
+	}()
+
+	if err := repl.New(program, repl.WithInterrupts(interrupts)).Run(); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(stderr.String(), "Error: Interrupted") || stdout.String() != "1 ok\n" {
+		t.Fatalf("Expected the loop to be interrupted and the next line to run, got %q and %q", stdout.String(), stderr.String())
+	}
+}
@@ -0,0 +1,205 @@
+package tests
+
+import (
+	"goforth/stack"
+	"testing"
+)
+
+func TestStackPopEmpty(t *testing.T) {
+	var s stack.Stack[int]
+	if value, ok := s.Pop(); ok || value != 0 {
+		t.Fatalf("Expected Pop on an empty stack to fail, got (%v, %v)", value, ok)
+	}
+}
+
+func TestStackPushPop(t *testing.T) {
+	var s stack.Stack[int]
+	s.Push(1)
+	s.Push(2)
+	if value, ok := s.Pop(); !ok || value != 2 {
+		t.Fatalf("Expected (2, true), got (%v, %v)", value, ok)
+	}
+
+	if value, ok := s.Pop(); !ok || value != 1 {
+		t.Fatalf("Expected (1, true), got (%v, %v)", value, ok)
+	}
+
+	if !s.IsEmpty() {
+		t.Fatalf("Expected an empty stack, got size %d", s.Size())
+	}
+}
+
+func TestStackMaxDepth(t *testing.T) {
+	var s = stack.NewStack[int](2)
+	if !s.Push(1) || !s.Push(2) {
+		t.Fatal("Expected pushes within the maximum depth to succeed")
+	}
+
+	if s.Push(3) {
+		t.Fatal("Expected a push past the maximum depth to report overflow")
+	}
+
+	if s.Insert(1, 3) {
+		t.Fatal("Expected an insert past the maximum depth to report overflow")
+	}
+
+	if s.Size() != 2 || *s.Top() != 2 {
+		t.Fatalf("Expected the stack to be unchanged after overflow, got %v", s.Array())
+	}
+}
+
+func TestStackClone(t *testing.T) {
+	var s = stack.NewStack[int](3)
+	s.Push(1)
+	s.Push(2)
+
+	var clone = s.Clone()
+	clone.Push(3)
+	*clone.Top() = 30
+	s.Push(4)
+
+	if clone.MaxDepth() != 3 || clone.Size() != 3 || *clone.Top() != 30 || *clone.Second() != 2 {
+		t.Fatalf("Unexpected clone contents %v", clone.Array())
+	}
+
+	if s.Size() != 3 || *s.Top() != 4 {
+		t.Fatalf("Cloning changed the original stack %v", s.Array())
+	}
+}
+
+func TestStackPeekOutOfRange(t *testing.T) {
+	var s stack.Stack[int]
+	s.Push(1)
+	if s.Peek(1) != nil || s.Peek(-1) != nil {
+		t.Fatal("Expected out-of-range Peek to return nil")
+	}
+
+	if *s.Peek(0) != 1 {
+		t.Fatalf("Expected Peek(0) to be 1, got %v", *s.Peek(0))
+	}
+}
+
+func TestStackInsertRemove(t *testing.T) {
+	var s stack.Stack[int]
+	s.Push(1)
+	s.Push(2)
+	s.Push(3)
+
+	s.Insert(2, 9)
+	if array := s.Array(); len(array) != 4 || array[0] != 3 || array[1] != 2 || array[2] != 9 || array[3] != 1 {
+		t.Fatalf("Expected [3 2 9 1], got %v", array)
+	}
+
+	if value, ok := s.Remove(3); !ok || value != 1 {
+		t.Fatalf("Expected (1, true), got (%v, %v)", value, ok)
+	}
+
+	if _, ok := s.Remove(3); ok {
+		t.Fatal("Expected an out-of-range Remove to fail")
+	}
+
+	if array := s.Array(); len(array) != 3 || array[0] != 3 || array[1] != 2 || array[2] != 9 {
+		t.Fatalf("Expected [3 2 9], got %v", array)
+	}
+}
+
+func TestStackRoll(t *testing.T) {
+	var s stack.Stack[int]
+	for i := 1; i <= 4; i++ {
+		s.Push(i)
+	}
+
+	s.Roll(3)
+	if array := s.Array(); array[0] != 1 || array[1] != 4 || array[2] != 3 || array[3] != 2 {
+		t.Fatalf("Expected [1 4 3 2], got %v", array)
+	}
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////
+
+type linkedNode[T any] struct {
+	element  T
+	previous *linkedNode[T]
+}
+
+type linkedStack[T any] struct {
+	top  *linkedNode[T]
+	size int
+}
+
+func (s *linkedStack[T]) Push(element T) {
+	s.top = &linkedNode[T]{element, s.top}
+	s.size++
+}
+
+func (s *linkedStack[T]) Pop() {
+	if s.top != nil {
+		s.top = s.top.previous
+		s.size--
+	}
+}
+
+func (s *linkedStack[T]) Peek(indexFromTop int) *T {
+	var currentNode = s.top
+	for i := 0; i < indexFromTop; i++ {
+		currentNode = currentNode.previous
+	}
+
+	return &currentNode.element
+}
+
+const benchmarkDepth = 64
+
+func BenchmarkLinkedStackPushPop(b *testing.B) {
+	b.ReportAllocs()
+	var s linkedStack[int]
+	for n := 0; n < b.N; n++ {
+		for i := 0; i < benchmarkDepth; i++ {
+			s.Push(i)
+		}
+
+		for i := 0; i < benchmarkDepth; i++ {
+			s.Pop()
+		}
+	}
+}
+
+func BenchmarkSliceStackPushPop(b *testing.B) {
+	b.ReportAllocs()
+	var s stack.Stack[int]
+	for n := 0; n < b.N; n++ {
+		for i := 0; i < benchmarkDepth; i++ {
+			s.Push(i)
+		}
+
+		for i := 0; i < benchmarkDepth; i++ {
+			s.Pop()
+		}
+	}
+}
+
+func BenchmarkLinkedStackPeek(b *testing.B) {
+	b.ReportAllocs()
+	var s linkedStack[int]
+	for i := 0; i < benchmarkDepth; i++ {
+		s.Push(i)
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = s.Peek(n % benchmarkDepth)
+	}
+}
+
+func BenchmarkSliceStackPeek(b *testing.B) {
+	b.ReportAllocs()
+	var s stack.Stack[int]
+	for i := 0; i < benchmarkDepth; i++ {
+		s.Push(i)
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = s.Peek(n % benchmarkDepth)
+	}
+}
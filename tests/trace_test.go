@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"goforth/forth"
+	"goforth/variant"
+	"strings"
+	"testing"
+)
+
+func TestTrace(t *testing.T) {
+	var trace strings.Builder
+	var program = forth.NewForthProgram(forth.WithTrace(&trace))
+	forth.ExecuteWordLine(program, ": square dup * ;")
+	if err := forth.ExecuteWordLine(program, "3 square 1 +"); err != nil {
+		t.Fatal(err)
+	}
+
+	var expected = "3 <1> 3\ndup <2> 3 3\n* <1> 9\nsquare <1> 9\n1 <2> 9 1\n+ <1> 10\n"
+	if trace.String() != expected {
+		t.Fatalf("Expected trace %q, got %q", expected, trace.String())
+	}
+}
+
+func TestTraceControlFlow(t *testing.T) {
+	var tests = map[string]string{
+		"2 0 do i drop loop":    "2 <1> 2\n0 <2> 2 0\ndo <0>\ni <1> 0\ndrop <0>\nloop <0>\ni <1> 1\ndrop <0>\nloop <0>\n",
+		"' dup":                 "' dup <1> dup\n",
+		"false if 99 then":      "false <1> false\nif <0>\nthen <0>\n",
+		"true if 1 else 2 then": "true <1> true\nif <0>\n1 <1> 1\nelse <1> 1\nthen <1> 1\n",
+	}
+
+	for line, expected := range tests {
+		var trace strings.Builder
+		var program = forth.NewForthProgram(forth.WithTrace(&trace))
+		if err := forth.ExecuteWordLine(program, line); err != nil {
+			t.Fatal(err)
+		}
+
+		if trace.String() != expected {
+			t.Fatalf("Tracing %q, expected %q, got %q", line, expected, trace.String())
+		}
+	}
+}
+
+func TestArgs(t *testing.T) {
+	var program = forth.NewForthProgram(forth.WithArgs([]string{"one", "two words"}))
+	if passed, err := runProgramTestLine(program, "args", variant.ForthList{variant.ForthString("one"), variant.ForthString("two words")}); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestLine("args length", variant.ForthInt(0)); !passed {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,203 @@
+package tests
+
+import (
+	"fmt"
+	"goforth/forth"
+	"goforth/variant"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type money int64
+
+func parseMoney(word string) (variant.Variant, bool) {
+	var dollars, found = strings.CutPrefix(word, "$")
+	if !found {
+		return nil, false
+	}
+
+	var value, err = strconv.ParseFloat(dollars, 64)
+	if err != nil {
+		return nil, false
+	}
+
+	return money(value*100 + 0.5), true
+}
+
+func scaleMoney(lhs variant.Variant, rhs variant.Variant) variant.Variant {
+	var amount, isMoney = lhs.(money)
+	var factor, isInt = rhs.(variant.ForthInt)
+	if !isMoney {
+		amount, isMoney = rhs.(money)
+		factor, isInt = lhs.(variant.ForthInt)
+	}
+
+	if !isMoney || !isInt {
+		variant.Raise(variant.ErrTypeMismatch, "Invalid '*' operands (%v and %v)", lhs, rhs)
+	}
+
+	return amount * money(factor)
+}
+
+func halveMoney(lhs variant.Variant, rhs variant.Variant) variant.Variant {
+	var amount, isMoney = lhs.(money)
+	var divisor, isInt = rhs.(variant.ForthInt)
+	if !isMoney || !isInt || divisor == 0 {
+		variant.Raise(variant.ErrTypeMismatch, "Invalid '/' operands (%v and %v)", lhs, rhs)
+	}
+
+	return amount / money(divisor)
+}
+
+func (m money) String() string {
+	return fmt.Sprintf("$%d.%02d", m/100, m%100)
+}
+
+func (m money) other(operator string, other variant.Variant) money {
+	if otherMoney, isMoney := other.(money); isMoney {
+		return otherMoney
+	}
+
+	variant.Raise(variant.ErrTypeMismatch, "Invalid '%s' operands (%v and %v)", operator, m, other)
+	return 0
+}
+
+func (m money) unsupported(operator string, other variant.Variant) variant.Variant {
+	variant.Raise(variant.ErrTypeMismatch, "Invalid '%s' operands (%v and %v)", operator, m, other)
+	return nil
+}
+
+func (m money) Add(other variant.Variant) variant.Variant { return m + m.other("+", other) }
+func (m money) Sub(other variant.Variant) variant.Variant { return m - m.other("-", other) }
+func (m money) Mul(other variant.Variant) variant.Variant { return m.unsupported("*", other) }
+func (m money) Div(other variant.Variant) variant.Variant { return m.unsupported("/", other) }
+func (m money) Mod(other variant.Variant) variant.Variant { return m.unsupported("%", other) }
+func (m money) And(other variant.Variant) variant.Variant { return m.unsupported("and", other) }
+func (m money) Or(other variant.Variant) variant.Variant  { return m.unsupported("or", other) }
+func (m money) Xor(other variant.Variant) variant.Variant { return m.unsupported("xor", other) }
+func (m money) Not() variant.Variant                      { return m.unsupported("not", nil) }
+
+func (m money) Eq(other variant.Variant) variant.Variant {
+	return variant.ForthBool(m == m.other("==", other))
+}
+
+func (m money) Ne(other variant.Variant) variant.Variant {
+	return variant.ForthBool(m != m.other("!=", other))
+}
+
+func (m money) Lt(other variant.Variant) variant.Variant {
+	return variant.ForthBool(m < m.other("<", other))
+}
+
+func (m money) Gt(other variant.Variant) variant.Variant {
+	return variant.ForthBool(m > m.other(">", other))
+}
+
+func (m money) Le(other variant.Variant) variant.Variant {
+	return variant.ForthBool(m <= m.other("<=", other))
+}
+
+func (m money) Ge(other variant.Variant) variant.Variant {
+	return variant.ForthBool(m >= m.other(">=", other))
+}
+
+func (m money) AsBool() bool                         { return m != 0 }
+func (m money) TypeName() string                     { return "money" }
+func (m money) ToInt() (variant.ForthInt, error)     { return variant.ForthInt(m / 100), nil }
+func (m money) ToFloat() (variant.ForthFloat, error) { return variant.ForthFloat(m) / 100, nil }
+func (m money) ToString() variant.ForthString        { return variant.ForthString(m.String()) }
+
+func newMoneyProgram(t *testing.T, options ...forth.ProgramOption) *forth.ForthProgram {
+	var program = forth.NewForthProgram(options...)
+	var err = program.RegisterType(forth.VariantType{
+		Name:      "money",
+		Prototype: money(0),
+		Parse:     parseMoney,
+		Operators: map[string]func(variant.Variant, variant.Variant) variant.Variant{"*": scaleMoney, "/": halveMoney},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return program
+}
+
+func TestRegisteredTypeLiterals(t *testing.T) {
+	var program = newMoneyProgram(t)
+	if passed, err := runProgramTestLine(program, "$1.50 $2.25 +", money(375), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runTestError("$1.50", variant.ErrUndefinedWord); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestRegisteredTypeOperators(t *testing.T) {
+	var program = newMoneyProgram(t)
+	if passed, err := runProgramTestLine(program, "3 $1.50 * $1.50 3 *", money(450), money(450), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runProgramTestError(program, "1 $1.50 +", variant.ErrTypeMismatch); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runProgramTestLine(program, "5 4 * 2.5 2 *", variant.ForthFloat(5), variant.ForthInt(20), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestRegisteredTypeWithBuiltinWords(t *testing.T) {
+	var program = newMoneyProgram(t)
+	if passed, err := runProgramTestLine(program, "$1.00 $5.00 max $2.00 $3.00 swap over min $0.10 $0.10 ==", variant.ForthBool(true), money(200), money(300), money(500), nil); !passed {
+		t.Fatal(err)
+	}
+
+	if passed, err := runProgramTestLine(program, "$2.50 typeof $2.50 >float $2.50 >int", variant.ForthInt(2), variant.ForthFloat(2.5), variant.ForthString("money"), nil); !passed {
+		t.Fatal(err)
+	}
+}
+
+func TestRegisteredTypeWithArithmeticWords(t *testing.T) {
+	var program = newMoneyProgram(t)
+	if passed, err := runProgramTestLine(program, "$1.50 negate dup abs $2.00 abs $1.25 2* $3.00 2/", money(150), money(250), money(200), money(150), money(-150), nil); !passed {
+		t.Fatal(err)
+	}
+
+	var err = forth.ExecuteWordLine(program, "$1.50 1+")
+	if forthError, isForthError := err.(*variant.ForthError); !isForthError || forthError.Message != "Invalid '+' operands ($1.50 and 1)" {
+		t.Fatalf("Expected '1+' to use the type's own '+', got %v", err)
+	}
+}
+
+func TestRegisteredTypePrinting(t *testing.T) {
+	var output strings.Builder
+	var program = newMoneyProgram(t, forth.WithStdout(&output))
+	if err := forth.ExecuteWordLine(program, "$1.05 . { $2.00 $0.99 } ."); err != nil {
+		t.Fatal(err)
+	}
+
+	if output.String() != "$1.05{ $2.00 $0.99 }" {
+		t.Fatalf("\nExpected: $1.05{ $2.00 $0.99 }\nGot: %v", output.String())
+	}
+}
+
+func TestRegisterTypeErrors(t *testing.T) {
+	var program = newMoneyProgram(t)
+	var tests = []forth.VariantType{
+		{Name: "money", Prototype: money(0)},
+		{Name: "cash", Prototype: money(0)},
+		{Name: "", Prototype: money(0)},
+		{Name: "empty"},
+		{Name: "other", Prototype: variant.ForthNil{}, Operators: map[string]func(variant.Variant, variant.Variant) variant.Variant{"**": scaleMoney}},
+	}
+
+	for _, variantType := range tests {
+		if err := program.RegisterType(variantType); err == nil {
+			t.Fatalf("Expected registering %+v to fail", variantType)
+		}
+	}
+}
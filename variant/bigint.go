@@ -0,0 +1,262 @@
+package variant
+
+import "math/big"
+
+type ForthBigInt struct {
+	value *big.Int
+}
+
+func NewForthBigInt(value *big.Int) ForthBigInt {
+	return ForthBigInt{new(big.Int).Set(value)}
+}
+
+func FromBigInt(value *big.Int) Variant {
+	if value.IsInt64() {
+		return ForthInt(value.Int64())
+	}
+
+	return ForthBigInt{value}
+}
+
+func (i ForthInt) toBigInt() *big.Int {
+	return big.NewInt(int64(i))
+}
+
+func (b ForthBigInt) BigInt() *big.Int {
+	return new(big.Int).Set(b.value)
+}
+
+func (b ForthBigInt) String() string {
+	return b.value.String()
+}
+
+func (b ForthBigInt) Float() ForthFloat {
+	var asFloat, _ = new(big.Float).SetInt(b.value).Float64()
+	return ForthFloat(asFloat)
+}
+
+func (b ForthBigInt) integerOperand(other Variant) (*big.Int, bool) {
+	switch otherCast := other.(type) {
+	case ForthInt:
+		return otherCast.toBigInt(), true
+	case ForthBigInt:
+		return otherCast.value, true
+	default:
+		return nil, false
+	}
+}
+
+func compareFloats(lhs ForthFloat, rhs ForthFloat) (result int, ordered bool) {
+	switch {
+	case lhs < rhs:
+		return -1, true
+	case lhs > rhs:
+		return 1, true
+	case lhs == rhs:
+		return 0, true
+	default:
+		return 0, false
+	}
+}
+
+func (b ForthBigInt) exactOperand(other Variant) (Variant, bool) {
+	switch other.(type) {
+	case ForthRational:
+		return ForthRational{new(big.Rat).SetInt(b.value)}, true
+	case ForthDecimal:
+		return ForthDecimal{b.value, 0}, true
+	default:
+		return nil, false
+	}
+}
+
+func (b ForthBigInt) compare(operator string, other Variant) (result int, ordered bool) {
+	if otherInt, isInteger := b.integerOperand(other); isInteger {
+		return b.value.Cmp(otherInt), true
+	} else if otherRat, isExact := toRat(other); isExact {
+		return new(big.Rat).SetInt(b.value).Cmp(otherRat), true
+	} else if otherFloat, isFloat := other.(ForthFloat); isFloat {
+		return compareFloats(b.Float(), otherFloat)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '%s' operands (%v and %v)", operator, b, other)
+	return 0, false
+}
+
+func (b ForthBigInt) Add(other Variant) Variant {
+	if otherInt, isInteger := b.integerOperand(other); isInteger {
+		return FromBigInt(new(big.Int).Add(b.value, otherInt))
+	} else if otherFloat, isFloat := other.(ForthFloat); isFloat {
+		return b.Float() + otherFloat
+	} else if promoted, isPromoted := b.exactOperand(other); isPromoted {
+		return promoted.Add(other)
+	} else if otherComplex, isComplex := other.(ForthComplex); isComplex {
+		return promoteComplex(b).Add(otherComplex)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '+' operands (%v and %v)", b, other)
+	return nil
+}
+
+func (b ForthBigInt) Sub(other Variant) Variant {
+	if otherInt, isInteger := b.integerOperand(other); isInteger {
+		return FromBigInt(new(big.Int).Sub(b.value, otherInt))
+	} else if otherFloat, isFloat := other.(ForthFloat); isFloat {
+		return b.Float() - otherFloat
+	} else if promoted, isPromoted := b.exactOperand(other); isPromoted {
+		return promoted.Sub(other)
+	} else if otherComplex, isComplex := other.(ForthComplex); isComplex {
+		return promoteComplex(b).Sub(otherComplex)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '-' operands (%v and %v)", b, other)
+	return nil
+}
+
+func (b ForthBigInt) Mul(other Variant) Variant {
+	if otherInt, isInteger := b.integerOperand(other); isInteger {
+		return FromBigInt(new(big.Int).Mul(b.value, otherInt))
+	} else if otherFloat, isFloat := other.(ForthFloat); isFloat {
+		return b.Float() * otherFloat
+	} else if promoted, isPromoted := b.exactOperand(other); isPromoted {
+		return promoted.Mul(other)
+	} else if otherComplex, isComplex := other.(ForthComplex); isComplex {
+		return promoteComplex(b).Mul(otherComplex)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '*' operands (%v and %v)", b, other)
+	return nil
+}
+
+func (b ForthBigInt) Div(other Variant) Variant {
+	if otherInt, isInteger := b.integerOperand(other); isInteger {
+		if otherInt.Sign() == 0 {
+			Raise(ErrDivisionByZero, "Division by zero (%v and %v)", b, other)
+		}
+
+		return FromBigInt(new(big.Int).Quo(b.value, otherInt))
+	} else if otherFloat, isFloat := other.(ForthFloat); isFloat {
+		return b.Float() / otherFloat
+	} else if promoted, isPromoted := b.exactOperand(other); isPromoted {
+		return promoted.Div(other)
+	} else if otherComplex, isComplex := other.(ForthComplex); isComplex {
+		return promoteComplex(b).Div(otherComplex)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '/' operands (%v and %v)", b, other)
+	return nil
+}
+
+func (b ForthBigInt) Mod(other Variant) Variant {
+	if otherInt, isInteger := b.integerOperand(other); isInteger {
+		if otherInt.Sign() == 0 {
+			Raise(ErrDivisionByZero, "Division by zero (%v and %v)", b, other)
+		}
+
+		return FromBigInt(new(big.Int).Rem(b.value, otherInt))
+	} else if otherFloat, isFloat := other.(ForthFloat); isFloat {
+		return b.Float().Mod(otherFloat)
+	} else if promoted, isPromoted := b.exactOperand(other); isPromoted {
+		return promoted.Mod(other)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '%%' operands (%v and %v)", b, other)
+	return nil
+}
+
+func (b ForthBigInt) And(other Variant) Variant {
+	if otherInt, isInteger := b.integerOperand(other); isInteger {
+		return FromBigInt(new(big.Int).And(b.value, otherInt))
+	}
+
+	Raise(ErrTypeMismatch, "Invalid 'and' operands (%v and %v)", b, other)
+	return nil
+}
+
+func (b ForthBigInt) Or(other Variant) Variant {
+	if otherInt, isInteger := b.integerOperand(other); isInteger {
+		return FromBigInt(new(big.Int).Or(b.value, otherInt))
+	}
+
+	Raise(ErrTypeMismatch, "Invalid 'or' operands (%v and %v)", b, other)
+	return nil
+}
+
+func (b ForthBigInt) Xor(other Variant) Variant {
+	if otherInt, isInteger := b.integerOperand(other); isInteger {
+		return FromBigInt(new(big.Int).Xor(b.value, otherInt))
+	}
+
+	Raise(ErrTypeMismatch, "Invalid 'xor' operands (%v and %v)", b, other)
+	return nil
+}
+
+func (b ForthBigInt) Not() Variant {
+	return FromBigInt(new(big.Int).Not(b.value))
+}
+
+func (b ForthBigInt) Eq(other Variant) Variant {
+	if _, isNil := other.(ForthNil); isNil {
+		return ForthBool(false)
+	}
+
+	if otherComplex, isComplex := other.(ForthComplex); isComplex {
+		return otherComplex.Eq(b)
+	}
+
+	var result, ordered = b.compare("==", other)
+	return ForthBool(ordered && result == 0)
+}
+
+func (b ForthBigInt) Ne(other Variant) Variant {
+	if _, isNil := other.(ForthNil); isNil {
+		return ForthBool(true)
+	}
+
+	if otherComplex, isComplex := other.(ForthComplex); isComplex {
+		return otherComplex.Ne(b)
+	}
+
+	var result, ordered = b.compare("!=", other)
+	return ForthBool(!ordered || result != 0)
+}
+
+func (b ForthBigInt) Lt(other Variant) Variant {
+	var result, ordered = b.compare("<", other)
+	return ForthBool(ordered && result < 0)
+}
+
+func (b ForthBigInt) Gt(other Variant) Variant {
+	var result, ordered = b.compare(">", other)
+	return ForthBool(ordered && result > 0)
+}
+
+func (b ForthBigInt) Le(other Variant) Variant {
+	var result, ordered = b.compare("<=", other)
+	return ForthBool(ordered && result <= 0)
+}
+
+func (b ForthBigInt) Ge(other Variant) Variant {
+	var result, ordered = b.compare(">=", other)
+	return ForthBool(ordered && result >= 0)
+}
+
+func (b ForthBigInt) AsBool() bool {
+	return b.value.Sign() != 0
+}
+
+func (b ForthBigInt) TypeName() string {
+	return "bigint"
+}
+
+func (b ForthBigInt) ToInt() (ForthInt, error) {
+	return truncateRat(new(big.Rat).SetInt(b.value), b)
+}
+
+func (b ForthBigInt) ToFloat() (ForthFloat, error) {
+	return b.Float(), nil
+}
+
+func (b ForthBigInt) ToString() ForthString {
+	return ForthString(b.String())
+}
@@ -0,0 +1,209 @@
+package variant
+
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+type ForthChar rune
+
+func ParseForthChar(text string) (ForthChar, bool) {
+	if len(text) < 3 || text[0] != '\'' || text[len(text)-1] != '\'' {
+		return 0, false
+	}
+
+	if unquoted, err := strconv.Unquote(text); err == nil {
+		var char, _ = utf8.DecodeRuneInString(unquoted)
+		return ForthChar(char), true
+	}
+
+	return 0, false
+}
+
+func ToChar(value Variant) (ForthChar, bool) {
+	switch valueCast := value.(type) {
+	case ForthChar:
+		return valueCast, true
+	case ForthInt:
+		if valueCast >= 0 && valueCast <= utf8.MaxRune && utf8.ValidRune(rune(valueCast)) {
+			return ForthChar(valueCast), true
+		}
+	case ForthString:
+		if utf8.RuneCountInString(string(valueCast)) == 1 {
+			var char, _ = utf8.DecodeRuneInString(string(valueCast))
+			return ForthChar(char), true
+		}
+	}
+
+	return 0, false
+}
+
+func (c ForthChar) String() string {
+	return string(rune(c))
+}
+
+func (c ForthChar) Add(other Variant) Variant {
+	switch otherCast := other.(type) {
+	case ForthInt:
+		return offsetChar(c, ForthInt(c)+otherCast, "+", other)
+	case ForthString:
+		return ForthString(c.String()) + otherCast
+	case ForthChar:
+		return ForthString(c.String() + otherCast.String())
+	default:
+		Raise(ErrTypeMismatch, "Invalid '+' operands (%v and %v)", c, other)
+		return nil
+	}
+}
+
+func (c ForthChar) Sub(other Variant) Variant {
+	switch otherCast := other.(type) {
+	case ForthInt:
+		return offsetChar(c, ForthInt(c)-otherCast, "-", other)
+	case ForthChar:
+		return ForthInt(c) - ForthInt(otherCast)
+	default:
+		Raise(ErrTypeMismatch, "Invalid '-' operands (%v and %v)", c, other)
+		return nil
+	}
+}
+
+func offsetChar(c ForthChar, codePoint ForthInt, op string, other Variant) Variant {
+	if char, isChar := ToChar(codePoint); isChar {
+		return char
+	}
+
+	Raise(ErrResultOutOfRange, "'%s' moved %q outside the valid characters (by %v)", op, rune(c), other)
+	return nil
+}
+
+func (c ForthChar) Mul(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '*' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthChar) Div(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '/' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthChar) Mod(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '%%' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthChar) And(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'and' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthChar) Or(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'or' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthChar) Xor(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'xor' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthChar) Not() Variant {
+	Raise(ErrTypeMismatch, "Invalid 'not' operand (%v)", c)
+	return nil
+}
+
+func (c ForthChar) Eq(other Variant) Variant {
+	switch otherCast := other.(type) {
+	case ForthChar:
+		return ForthBool(c == otherCast)
+	case ForthInt:
+		return ForthBool(ForthInt(c) == otherCast)
+	case ForthNil:
+		return ForthBool(false)
+	default:
+		Raise(ErrTypeMismatch, "Invalid '==' operands (%v and %v)", c, other)
+		return nil
+	}
+}
+
+func (c ForthChar) Ne(other Variant) Variant {
+	switch otherCast := other.(type) {
+	case ForthChar:
+		return ForthBool(c != otherCast)
+	case ForthInt:
+		return ForthBool(ForthInt(c) != otherCast)
+	case ForthNil:
+		return ForthBool(true)
+	default:
+		Raise(ErrTypeMismatch, "Invalid '!=' operands (%v and %v)", c, other)
+		return nil
+	}
+}
+
+func (c ForthChar) Lt(other Variant) Variant {
+	switch otherCast := other.(type) {
+	case ForthChar:
+		return ForthBool(c < otherCast)
+	case ForthInt:
+		return ForthBool(ForthInt(c) < otherCast)
+	default:
+		Raise(ErrTypeMismatch, "Invalid '<' operands (%v and %v)", c, other)
+		return nil
+	}
+}
+
+func (c ForthChar) Gt(other Variant) Variant {
+	switch otherCast := other.(type) {
+	case ForthChar:
+		return ForthBool(c > otherCast)
+	case ForthInt:
+		return ForthBool(ForthInt(c) > otherCast)
+	default:
+		Raise(ErrTypeMismatch, "Invalid '>' operands (%v and %v)", c, other)
+		return nil
+	}
+}
+
+func (c ForthChar) Le(other Variant) Variant {
+	switch otherCast := other.(type) {
+	case ForthChar:
+		return ForthBool(c <= otherCast)
+	case ForthInt:
+		return ForthBool(ForthInt(c) <= otherCast)
+	default:
+		Raise(ErrTypeMismatch, "Invalid '<=' operands (%v and %v)", c, other)
+		return nil
+	}
+}
+
+func (c ForthChar) Ge(other Variant) Variant {
+	switch otherCast := other.(type) {
+	case ForthChar:
+		return ForthBool(c >= otherCast)
+	case ForthInt:
+		return ForthBool(ForthInt(c) >= otherCast)
+	default:
+		Raise(ErrTypeMismatch, "Invalid '>=' operands (%v and %v)", c, other)
+		return nil
+	}
+}
+
+func (c ForthChar) AsBool() bool {
+	return c != 0
+}
+
+func (c ForthChar) TypeName() string {
+	return "char"
+}
+
+func (c ForthChar) ToInt() (ForthInt, error) {
+	return ForthInt(c), nil
+}
+
+func (c ForthChar) ToFloat() (ForthFloat, error) {
+	return 0, conversionError(c, "float")
+}
+
+func (c ForthChar) ToString() ForthString {
+	return ForthString(c.String())
+}
@@ -0,0 +1,105 @@
+package variant
+
+import (
+	"math"
+	"math/big"
+)
+
+func intOperands(lhs Variant, rhs Variant) (ForthInt, ForthInt, bool) {
+	var lhsRank, lhsRanked = rankOf(lhs)
+	var rhsRank, rhsRanked = rankOf(rhs)
+	if !lhsRanked || !rhsRanked || max(lhsRank, rhsRank) > rankInt {
+		return 0, 0, false
+	}
+
+	var lhsInt, _ = lhs.ToInt()
+	var rhsInt, _ = rhs.ToInt()
+	return lhsInt, rhsInt, true
+}
+
+func addOverflows(lhs ForthInt, rhs ForthInt) bool {
+	var sum = lhs + rhs
+	return (lhs > 0 && rhs > 0 && sum < 0) || (lhs < 0 && rhs < 0 && sum >= 0)
+}
+
+func subOverflows(lhs ForthInt, rhs ForthInt) bool {
+	var difference = lhs - rhs
+	return (lhs >= 0 && rhs < 0 && difference < 0) || (lhs < 0 && rhs > 0 && difference >= 0)
+}
+
+func mulOverflows(lhs ForthInt, rhs ForthInt) bool {
+	var product = lhs * rhs
+	return lhs != 0 && (product/lhs != rhs || (lhs == -1 && rhs == math.MinInt64))
+}
+
+func divOverflows(lhs ForthInt, rhs ForthInt) bool {
+	return lhs == math.MinInt64 && rhs == -1
+}
+
+func raiseOverflow(operator string, lhs ForthInt, rhs ForthInt) {
+	Raise(ErrResultOutOfRange, "Integer overflow in '%s' (%v and %v)", operator, lhs, rhs)
+}
+
+func CheckedAdd(lhs Variant, rhs Variant) Variant {
+	if lhsInt, rhsInt, bothInts := intOperands(lhs, rhs); bothInts && addOverflows(lhsInt, rhsInt) {
+		raiseOverflow("+", lhsInt, rhsInt)
+	}
+
+	return lhs.Add(rhs)
+}
+
+func CheckedSub(lhs Variant, rhs Variant) Variant {
+	if lhsInt, rhsInt, bothInts := intOperands(lhs, rhs); bothInts && subOverflows(lhsInt, rhsInt) {
+		raiseOverflow("-", lhsInt, rhsInt)
+	}
+
+	return lhs.Sub(rhs)
+}
+
+func CheckedMul(lhs Variant, rhs Variant) Variant {
+	if lhsInt, rhsInt, bothInts := intOperands(lhs, rhs); bothInts && mulOverflows(lhsInt, rhsInt) {
+		raiseOverflow("*", lhsInt, rhsInt)
+	}
+
+	return lhs.Mul(rhs)
+}
+
+func CheckedDiv(lhs Variant, rhs Variant) Variant {
+	if lhsInt, rhsInt, bothInts := intOperands(lhs, rhs); bothInts && divOverflows(lhsInt, rhsInt) {
+		raiseOverflow("/", lhsInt, rhsInt)
+	}
+
+	return lhs.Div(rhs)
+}
+
+func PromotingAdd(lhs Variant, rhs Variant) Variant {
+	if lhsInt, rhsInt, bothInts := intOperands(lhs, rhs); bothInts && addOverflows(lhsInt, rhsInt) {
+		return ForthBigInt{new(big.Int).Add(lhsInt.toBigInt(), rhsInt.toBigInt())}
+	}
+
+	return lhs.Add(rhs)
+}
+
+func PromotingSub(lhs Variant, rhs Variant) Variant {
+	if lhsInt, rhsInt, bothInts := intOperands(lhs, rhs); bothInts && subOverflows(lhsInt, rhsInt) {
+		return ForthBigInt{new(big.Int).Sub(lhsInt.toBigInt(), rhsInt.toBigInt())}
+	}
+
+	return lhs.Sub(rhs)
+}
+
+func PromotingMul(lhs Variant, rhs Variant) Variant {
+	if lhsInt, rhsInt, bothInts := intOperands(lhs, rhs); bothInts && mulOverflows(lhsInt, rhsInt) {
+		return ForthBigInt{new(big.Int).Mul(lhsInt.toBigInt(), rhsInt.toBigInt())}
+	}
+
+	return lhs.Mul(rhs)
+}
+
+func PromotingDiv(lhs Variant, rhs Variant) Variant {
+	if lhsInt, rhsInt, bothInts := intOperands(lhs, rhs); bothInts && divOverflows(lhsInt, rhsInt) {
+		return ForthBigInt{new(big.Int).Quo(lhsInt.toBigInt(), rhsInt.toBigInt())}
+	}
+
+	return lhs.Div(rhs)
+}
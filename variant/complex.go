@@ -0,0 +1,192 @@
+package variant
+
+import (
+	"math/cmplx"
+	"strconv"
+	"strings"
+)
+
+type ForthComplex complex128
+
+func ParseForthComplex(text string) (ForthComplex, bool) {
+	if !strings.HasSuffix(text, "i") {
+		return 0, false
+	}
+
+	if value, err := strconv.ParseComplex(text, 128); err == nil && !cmplx.IsInf(value) && !cmplx.IsNaN(value) {
+		return ForthComplex(value), true
+	}
+
+	return 0, false
+}
+
+func ToComplex(value Variant) (ForthComplex, bool) {
+	switch valueCast := value.(type) {
+	case ForthInt:
+		return ForthComplex(complex(float64(valueCast), 0)), true
+	case ForthBigInt:
+		return ForthComplex(complex(float64(valueCast.Float()), 0)), true
+	case ForthRational:
+		return ForthComplex(complex(float64(valueCast.Float()), 0)), true
+	case ForthDecimal:
+		return ForthComplex(complex(float64(valueCast.Float()), 0)), true
+	case ForthFloat:
+		return ForthComplex(complex(float64(valueCast), 0)), true
+	case ForthComplex:
+		return valueCast, true
+	default:
+		return 0, false
+	}
+}
+
+func promoteComplex(value Variant) ForthComplex {
+	var promoted, _ = ToComplex(value)
+	return promoted
+}
+
+func (c ForthComplex) String() string {
+	return strconv.FormatComplex(complex128(c), 'g', -1, 128)
+}
+
+func (c ForthComplex) Abs() ForthFloat {
+	return ForthFloat(cmplx.Abs(complex128(c)))
+}
+
+func (c ForthComplex) Add(other Variant) Variant {
+	if otherComplex, isNumber := ToComplex(other); isNumber {
+		return c + otherComplex
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '+' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthComplex) Sub(other Variant) Variant {
+	if otherComplex, isNumber := ToComplex(other); isNumber {
+		return c - otherComplex
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '-' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthComplex) Mul(other Variant) Variant {
+	if otherComplex, isNumber := ToComplex(other); isNumber {
+		return c * otherComplex
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '*' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthComplex) Div(other Variant) Variant {
+	if otherComplex, isNumber := ToComplex(other); isNumber {
+		if otherComplex == 0 {
+			Raise(ErrDivisionByZero, "Division by zero (%v and %v)", c, other)
+		}
+
+		return c / otherComplex
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '/' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthComplex) Mod(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '%%' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthComplex) And(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'and' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthComplex) Or(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'or' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthComplex) Xor(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'xor' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthComplex) Not() Variant {
+	Raise(ErrTypeMismatch, "Invalid 'not' operand (%v)", c)
+	return nil
+}
+
+func (c ForthComplex) Eq(other Variant) Variant {
+	if _, isNil := other.(ForthNil); isNil {
+		return ForthBool(false)
+	}
+
+	if otherComplex, isNumber := ToComplex(other); isNumber {
+		return ForthBool(c == otherComplex)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '==' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthComplex) Ne(other Variant) Variant {
+	if _, isNil := other.(ForthNil); isNil {
+		return ForthBool(true)
+	}
+
+	if otherComplex, isNumber := ToComplex(other); isNumber {
+		return ForthBool(c != otherComplex)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '!=' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthComplex) Lt(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '<' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthComplex) Gt(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '>' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthComplex) Le(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '<=' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthComplex) Ge(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '>=' operands (%v and %v)", c, other)
+	return nil
+}
+
+func (c ForthComplex) AsBool() bool {
+	return c != 0
+}
+
+func (c ForthComplex) TypeName() string {
+	return "complex"
+}
+
+func (c ForthComplex) ToInt() (ForthInt, error) {
+	if imag(c) != 0 {
+		return 0, conversionError(c, "int")
+	}
+
+	return truncateFloat(ForthFloat(real(c)))
+}
+
+func (c ForthComplex) ToFloat() (ForthFloat, error) {
+	if imag(c) != 0 {
+		return 0, conversionError(c, "float")
+	}
+
+	return ForthFloat(real(c)), nil
+}
+
+func (c ForthComplex) ToString() ForthString {
+	return ForthString(c.String())
+}
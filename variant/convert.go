@@ -0,0 +1,27 @@
+package variant
+
+import (
+	"math"
+	"math/big"
+)
+
+func conversionError(value Variant, target string) error {
+	return NewError(ErrTypeMismatch, "Cannot convert %v (%s) to %s", value, value.TypeName(), target)
+}
+
+func truncateFloat(value ForthFloat) (ForthInt, error) {
+	if math.IsNaN(float64(value)) || value < math.MinInt64 || value >= math.MaxInt64 {
+		return 0, NewError(ErrResultOutOfRange, "%v is out of integer range", value)
+	}
+
+	return ForthInt(value), nil
+}
+
+func truncateRat(value *big.Rat, original Variant) (ForthInt, error) {
+	var truncated = new(big.Int).Quo(value.Num(), value.Denom())
+	if !truncated.IsInt64() {
+		return 0, NewError(ErrResultOutOfRange, "%v is out of integer range", original)
+	}
+
+	return ForthInt(truncated.Int64()), nil
+}
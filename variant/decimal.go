@@ -0,0 +1,300 @@
+package variant
+
+import (
+	"math/big"
+	"strings"
+)
+
+type ForthDecimal struct {
+	unscaled *big.Int
+	scale    int
+}
+
+func NewForthDecimal(unscaled *big.Int, scale int) ForthDecimal {
+	return ForthDecimal{new(big.Int).Set(unscaled), scale}
+}
+
+func ParseForthDecimal(text string) (ForthDecimal, bool) {
+	var body, hasSuffix = strings.CutSuffix(text, "d")
+	if !hasSuffix {
+		return ForthDecimal{}, false
+	}
+
+	var sign = ""
+	if strings.HasPrefix(body, "-") || strings.HasPrefix(body, "+") {
+		sign, body = body[:1], body[1:]
+	}
+
+	var whole, fraction, _ = strings.Cut(body, ".")
+	if whole == "" || !isDigits(whole) || !isDigits(fraction) {
+		return ForthDecimal{}, false
+	}
+
+	var unscaled, ok = new(big.Int).SetString(sign+whole+fraction, 10)
+	return ForthDecimal{unscaled, len(fraction)}, ok
+}
+
+func isDigits(text string) bool {
+	for _, r := range text {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func powerOfTen(exponent int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+}
+
+func decimalFromRat(value *big.Rat, scale int) ForthDecimal {
+	var scaled = new(big.Int).Mul(value.Num(), powerOfTen(scale))
+	var quotient, remainder = new(big.Int).QuoRem(scaled, value.Denom(), new(big.Int))
+
+	var twiceRemainder = new(big.Int).Abs(remainder)
+	twiceRemainder.Lsh(twiceRemainder, 1)
+	if comparison := twiceRemainder.Cmp(value.Denom()); comparison > 0 || (comparison == 0 && quotient.Bit(0) == 1) {
+		quotient.Add(quotient, big.NewInt(int64(value.Sign())))
+	}
+
+	return ForthDecimal{quotient, scale}
+}
+
+func (i ForthInt) toDecimal() ForthDecimal {
+	return ForthDecimal{big.NewInt(int64(i)), 0}
+}
+
+func decimalOperand(value Variant) (ForthDecimal, bool) {
+	switch valueCast := value.(type) {
+	case ForthInt:
+		return valueCast.toDecimal(), true
+	case ForthBigInt:
+		return ForthDecimal{valueCast.value, 0}, true
+	case ForthDecimal:
+		return valueCast, true
+	default:
+		return ForthDecimal{}, false
+	}
+}
+
+func (d ForthDecimal) rescale(scale int) *big.Int {
+	return new(big.Int).Mul(d.unscaled, powerOfTen(scale-d.scale))
+}
+
+func alignDecimals(lhs ForthDecimal, rhs ForthDecimal) (*big.Int, *big.Int, int) {
+	var scale = max(lhs.scale, rhs.scale)
+	return lhs.rescale(scale), rhs.rescale(scale), scale
+}
+
+func (d ForthDecimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.unscaled, powerOfTen(d.scale))
+}
+
+func (d ForthDecimal) String() string {
+	var digits = new(big.Int).Abs(d.unscaled).String()
+	if len(digits) <= d.scale {
+		digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+	}
+
+	var sign = ""
+	if d.unscaled.Sign() < 0 {
+		sign = "-"
+	}
+
+	if d.scale == 0 {
+		return sign + digits
+	}
+
+	return sign + digits[:len(digits)-d.scale] + "." + digits[len(digits)-d.scale:]
+}
+
+func (d ForthDecimal) Float() ForthFloat {
+	var asFloat, _ = d.Rat().Float64()
+	return ForthFloat(asFloat)
+}
+
+func (d ForthDecimal) compare(operator string, other Variant) (result int, ordered bool) {
+	if otherRat, isExact := toRat(other); isExact {
+		return d.Rat().Cmp(otherRat), true
+	} else if otherFloat, isFloat := other.(ForthFloat); isFloat {
+		return compareFloats(d.Float(), otherFloat)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '%s' operands (%v and %v)", operator, d, other)
+	return 0, false
+}
+
+func (d ForthDecimal) Add(other Variant) Variant {
+	if otherDecimal, isDecimal := decimalOperand(other); isDecimal {
+		var lhs, rhs, scale = alignDecimals(d, otherDecimal)
+		return ForthDecimal{lhs.Add(lhs, rhs), scale}
+	} else if otherRational, isRational := other.(ForthRational); isRational {
+		return ForthRational{d.Rat()}.Add(otherRational)
+	} else if otherFloat, isFloat := other.(ForthFloat); isFloat {
+		return d.Float() + otherFloat
+	} else if otherComplex, isComplex := other.(ForthComplex); isComplex {
+		return promoteComplex(d).Add(otherComplex)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '+' operands (%v and %v)", d, other)
+	return nil
+}
+
+func (d ForthDecimal) Sub(other Variant) Variant {
+	if otherDecimal, isDecimal := decimalOperand(other); isDecimal {
+		var lhs, rhs, scale = alignDecimals(d, otherDecimal)
+		return ForthDecimal{lhs.Sub(lhs, rhs), scale}
+	} else if otherRational, isRational := other.(ForthRational); isRational {
+		return ForthRational{d.Rat()}.Sub(otherRational)
+	} else if otherFloat, isFloat := other.(ForthFloat); isFloat {
+		return d.Float() - otherFloat
+	} else if otherComplex, isComplex := other.(ForthComplex); isComplex {
+		return promoteComplex(d).Sub(otherComplex)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '-' operands (%v and %v)", d, other)
+	return nil
+}
+
+func (d ForthDecimal) Mul(other Variant) Variant {
+	if otherDecimal, isDecimal := decimalOperand(other); isDecimal {
+		return ForthDecimal{new(big.Int).Mul(d.unscaled, otherDecimal.unscaled), d.scale + otherDecimal.scale}
+	} else if otherRational, isRational := other.(ForthRational); isRational {
+		return ForthRational{d.Rat()}.Mul(otherRational)
+	} else if otherFloat, isFloat := other.(ForthFloat); isFloat {
+		return d.Float() * otherFloat
+	} else if otherComplex, isComplex := other.(ForthComplex); isComplex {
+		return promoteComplex(d).Mul(otherComplex)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '*' operands (%v and %v)", d, other)
+	return nil
+}
+
+// Div keeps the larger of the two scales, rounding half to even, so dividing
+// amounts in cents gives cents: 10.00d 3 / is 3.33 and 1d 3 / is 0. Give the
+// dividend more places, or divide rationals, to keep more precision.
+func (d ForthDecimal) Div(other Variant) Variant {
+	if otherDecimal, isDecimal := decimalOperand(other); isDecimal {
+		if otherDecimal.unscaled.Sign() == 0 {
+			Raise(ErrDivisionByZero, "Division by zero (%v and %v)", d, other)
+		}
+
+		var quotient = new(big.Rat).Quo(d.Rat(), otherDecimal.Rat())
+		return decimalFromRat(quotient, max(d.scale, otherDecimal.scale))
+	} else if otherRational, isRational := other.(ForthRational); isRational {
+		return ForthRational{d.Rat()}.Div(otherRational)
+	} else if otherFloat, isFloat := other.(ForthFloat); isFloat {
+		return d.Float() / otherFloat
+	} else if otherComplex, isComplex := other.(ForthComplex); isComplex {
+		return promoteComplex(d).Div(otherComplex)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '/' operands (%v and %v)", d, other)
+	return nil
+}
+
+func (d ForthDecimal) Mod(other Variant) Variant {
+	if otherDecimal, isDecimal := decimalOperand(other); isDecimal {
+		if otherDecimal.unscaled.Sign() == 0 {
+			Raise(ErrDivisionByZero, "Division by zero (%v and %v)", d, other)
+		}
+
+		var lhs, rhs, scale = alignDecimals(d, otherDecimal)
+		return ForthDecimal{lhs.Rem(lhs, rhs), scale}
+	} else if otherRational, isRational := other.(ForthRational); isRational {
+		return ForthRational{d.Rat()}.Mod(otherRational)
+	} else if otherFloat, isFloat := other.(ForthFloat); isFloat {
+		return d.Float().Mod(otherFloat)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '%%' operands (%v and %v)", d, other)
+	return nil
+}
+
+func (d ForthDecimal) And(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'and' operands (%v and %v)", d, other)
+	return nil
+}
+
+func (d ForthDecimal) Or(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'or' operands (%v and %v)", d, other)
+	return nil
+}
+
+func (d ForthDecimal) Xor(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'xor' operands (%v and %v)", d, other)
+	return nil
+}
+
+func (d ForthDecimal) Not() Variant {
+	Raise(ErrTypeMismatch, "Invalid 'not' operand (%v)", d)
+	return nil
+}
+
+func (d ForthDecimal) Eq(other Variant) Variant {
+	if _, isNil := other.(ForthNil); isNil {
+		return ForthBool(false)
+	}
+
+	if otherComplex, isComplex := other.(ForthComplex); isComplex {
+		return otherComplex.Eq(d)
+	}
+
+	var result, ordered = d.compare("==", other)
+	return ForthBool(ordered && result == 0)
+}
+
+func (d ForthDecimal) Ne(other Variant) Variant {
+	if _, isNil := other.(ForthNil); isNil {
+		return ForthBool(true)
+	}
+
+	if otherComplex, isComplex := other.(ForthComplex); isComplex {
+		return otherComplex.Ne(d)
+	}
+
+	var result, ordered = d.compare("!=", other)
+	return ForthBool(!ordered || result != 0)
+}
+
+func (d ForthDecimal) Lt(other Variant) Variant {
+	var result, ordered = d.compare("<", other)
+	return ForthBool(ordered && result < 0)
+}
+
+func (d ForthDecimal) Gt(other Variant) Variant {
+	var result, ordered = d.compare(">", other)
+	return ForthBool(ordered && result > 0)
+}
+
+func (d ForthDecimal) Le(other Variant) Variant {
+	var result, ordered = d.compare("<=", other)
+	return ForthBool(ordered && result <= 0)
+}
+
+func (d ForthDecimal) Ge(other Variant) Variant {
+	var result, ordered = d.compare(">=", other)
+	return ForthBool(ordered && result >= 0)
+}
+
+func (d ForthDecimal) AsBool() bool {
+	return d.unscaled.Sign() != 0
+}
+
+func (d ForthDecimal) TypeName() string {
+	return "decimal"
+}
+
+func (d ForthDecimal) ToInt() (ForthInt, error) {
+	return truncateRat(d.Rat(), d)
+}
+
+func (d ForthDecimal) ToFloat() (ForthFloat, error) {
+	return d.Float(), nil
+}
+
+func (d ForthDecimal) ToString() ForthString {
+	return ForthString(d.String())
+}
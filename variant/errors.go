@@ -0,0 +1,54 @@
+package variant
+
+import "fmt"
+
+type ErrorCode int
+
+const (
+	ErrAbort                  ErrorCode = -1
+	ErrStackOverflow          ErrorCode = -3
+	ErrStackUnderflow         ErrorCode = -4
+	ErrInvalidMemoryAddress   ErrorCode = -9
+	ErrDivisionByZero         ErrorCode = -10
+	ErrResultOutOfRange       ErrorCode = -11
+	ErrTypeMismatch           ErrorCode = -12
+	ErrUndefinedWord          ErrorCode = -13
+	ErrZeroLengthName         ErrorCode = -16
+	ErrControlMismatch        ErrorCode = -22
+	ErrInvalidNumericArgument ErrorCode = -24
+	ErrUserInterrupt          ErrorCode = -28
+	ErrFileIO                 ErrorCode = -37
+	ErrNonExistentFile        ErrorCode = -38
+	ErrFloatStackUnderflow    ErrorCode = -45
+	ErrInvalidFloatArgument   ErrorCode = -46
+
+	ErrStepLimitExceeded   ErrorCode = -256
+	ErrMemoryLimitExceeded ErrorCode = -257
+	ErrOutputLimitExceeded ErrorCode = -258
+	ErrWordNotPermitted    ErrorCode = -259
+	ErrBye                 ErrorCode = -260
+	ErrIncludeCycle        ErrorCode = -261
+	ErrHostWordRedefined   ErrorCode = -262
+)
+
+type ForthError struct {
+	Code    ErrorCode
+	Message string
+	Cause   error
+}
+
+func (err *ForthError) Error() string {
+	return err.Message
+}
+
+func (err *ForthError) Unwrap() error {
+	return err.Cause
+}
+
+func NewError(code ErrorCode, format string, args ...any) *ForthError {
+	return &ForthError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func Raise(code ErrorCode, format string, args ...any) {
+	panic(NewError(code, format, args...))
+}
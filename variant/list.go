@@ -0,0 +1,161 @@
+package variant
+
+import "strings"
+
+type ForthList []Variant
+
+func Equal(lhs Variant, rhs Variant) (equal bool) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			if _, isForthError := recovered.(*ForthError); !isForthError {
+				panic(recovered)
+			}
+
+			equal = false
+		}
+	}()
+
+	return lhs.Eq(rhs).AsBool()
+}
+
+func (l ForthList) String() string {
+	var builder strings.Builder
+	builder.WriteString("{ ")
+	for _, element := range l {
+		builder.WriteString(string(element.ToString()))
+		builder.WriteString(" ")
+	}
+
+	builder.WriteString("}")
+	return builder.String()
+}
+
+func (l ForthList) equals(other ForthList) bool {
+	if len(l) != len(other) {
+		return false
+	}
+
+	for i := range l {
+		if !Equal(l[i], other[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (l ForthList) Add(other Variant) Variant {
+	switch otherCast := other.(type) {
+	case ForthList:
+		var result = make(ForthList, 0, len(l)+len(otherCast))
+		result = append(result, l...)
+		return append(result, otherCast...)
+	default:
+		Raise(ErrTypeMismatch, "Invalid '+' operands (%v and %v)", l, other)
+		return nil
+	}
+}
+
+func (l ForthList) Sub(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '-' operands (%v and %v)", l, other)
+	return nil
+}
+
+func (l ForthList) Mul(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '*' operands (%v and %v)", l, other)
+	return nil
+}
+
+func (l ForthList) Div(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '/' operands (%v and %v)", l, other)
+	return nil
+}
+
+func (l ForthList) Mod(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '%%' operands (%v and %v)", l, other)
+	return nil
+}
+
+func (l ForthList) And(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'and' operands (%v and %v)", l, other)
+	return nil
+}
+
+func (l ForthList) Or(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'or' operands (%v and %v)", l, other)
+	return nil
+}
+
+func (l ForthList) Xor(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'xor' operands (%v and %v)", l, other)
+	return nil
+}
+
+func (l ForthList) Not() Variant {
+	Raise(ErrTypeMismatch, "Invalid 'not' operand (%v)", l)
+	return nil
+}
+
+func (l ForthList) Eq(other Variant) Variant {
+	switch otherCast := other.(type) {
+	case ForthList:
+		return ForthBool(l.equals(otherCast))
+	case ForthNil:
+		return ForthBool(false)
+	default:
+		Raise(ErrTypeMismatch, "Invalid '==' operands (%v and %v)", l, other)
+		return nil
+	}
+}
+
+func (l ForthList) Ne(other Variant) Variant {
+	switch otherCast := other.(type) {
+	case ForthList:
+		return ForthBool(!l.equals(otherCast))
+	case ForthNil:
+		return ForthBool(true)
+	default:
+		Raise(ErrTypeMismatch, "Invalid '!=' operands (%v and %v)", l, other)
+		return nil
+	}
+}
+
+func (l ForthList) Lt(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '<' operands (%v and %v)", l, other)
+	return nil
+}
+
+func (l ForthList) Gt(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '>' operands (%v and %v)", l, other)
+	return nil
+}
+
+func (l ForthList) Le(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '<=' operands (%v and %v)", l, other)
+	return nil
+}
+
+func (l ForthList) Ge(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '>=' operands (%v and %v)", l, other)
+	return nil
+}
+
+func (l ForthList) AsBool() bool {
+	return len(l) != 0
+}
+
+func (l ForthList) TypeName() string {
+	return "list"
+}
+
+func (l ForthList) ToInt() (ForthInt, error) {
+	return 0, conversionError(l, "int")
+}
+
+func (l ForthList) ToFloat() (ForthFloat, error) {
+	return 0, conversionError(l, "float")
+}
+
+func (l ForthList) ToString() ForthString {
+	return ForthString(l.String())
+}
@@ -0,0 +1,250 @@
+package variant
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ForthMap keeps its entries in insertion order and matches keys with Eq,
+// so 1 and 1.0 name the same entry. Like ForthList it is never changed in
+// place: Put and Delete return a copy.
+type ForthMap struct {
+	entries []mapEntry
+}
+
+type mapEntry struct {
+	key   Variant
+	value Variant
+}
+
+func NewForthMap() ForthMap {
+	return ForthMap{}
+}
+
+// IsValidMapKey accepts every value that Eq can compare except lists, maps
+// and NaN, which never equals itself.
+func IsValidMapKey(key Variant) bool {
+	switch key.(type) {
+	case ForthList, ForthMap:
+		return false
+	case ForthString:
+		return true
+	}
+
+	if number, err := key.ToFloat(); err == nil {
+		return number == number
+	}
+
+	return true
+}
+
+func checkMapKey(key Variant) {
+	if !IsValidMapKey(key) {
+		Raise(ErrTypeMismatch, "Invalid map key (%v)", key)
+	}
+}
+
+func (m ForthMap) index(key Variant) int {
+	return slices.IndexFunc(m.entries, func(entry mapEntry) bool {
+		return Equal(entry.key, key)
+	})
+}
+
+func (m ForthMap) Len() int {
+	return len(m.entries)
+}
+
+func (m ForthMap) Keys() []Variant {
+	var keys = make([]Variant, len(m.entries))
+	for i, entry := range m.entries {
+		keys[i] = entry.key
+	}
+
+	return keys
+}
+
+func (m ForthMap) Values() []Variant {
+	var values = make([]Variant, len(m.entries))
+	for i, entry := range m.entries {
+		values[i] = entry.value
+	}
+
+	return values
+}
+
+func (m ForthMap) Get(key Variant) (Variant, bool) {
+	checkMapKey(key)
+	if index := m.index(key); index >= 0 {
+		return m.entries[index].value, true
+	}
+
+	return nil, false
+}
+
+// Put keeps the original key and position when key is Eq to one already in
+// the map.
+func (m ForthMap) Put(key Variant, value Variant) ForthMap {
+	checkMapKey(key)
+	if index := m.index(key); index >= 0 {
+		var entries = slices.Clone(m.entries)
+		entries[index].value = value
+		return ForthMap{entries}
+	}
+
+	return ForthMap{append(slices.Clip(m.entries), mapEntry{key, value})}
+}
+
+func (m ForthMap) Delete(key Variant) ForthMap {
+	checkMapKey(key)
+	var index = m.index(key)
+	if index < 0 {
+		return m
+	}
+
+	return ForthMap{slices.Delete(slices.Clone(m.entries), index, index+1)}
+}
+
+func (m ForthMap) String() string {
+	var builder strings.Builder
+	builder.WriteString("#{ ")
+	for _, entry := range m.entries {
+		fmt.Fprintf(&builder, "%s: %s ", entry.key.ToString(), entry.value.ToString())
+	}
+
+	builder.WriteString("}")
+	return builder.String()
+}
+
+func (m ForthMap) equals(other ForthMap) bool {
+	if len(m.entries) != len(other.entries) {
+		return false
+	}
+
+	for _, entry := range m.entries {
+		if otherValue, found := other.Get(entry.key); !found || !Equal(entry.value, otherValue) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m ForthMap) Add(other Variant) Variant {
+	switch otherCast := other.(type) {
+	case ForthMap:
+		var result = m
+		for _, entry := range otherCast.entries {
+			result = result.Put(entry.key, entry.value)
+		}
+
+		return result
+	default:
+		Raise(ErrTypeMismatch, "Invalid '+' operands (%v and %v)", m, other)
+		return nil
+	}
+}
+
+func (m ForthMap) Sub(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '-' operands (%v and %v)", m, other)
+	return nil
+}
+
+func (m ForthMap) Mul(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '*' operands (%v and %v)", m, other)
+	return nil
+}
+
+func (m ForthMap) Div(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '/' operands (%v and %v)", m, other)
+	return nil
+}
+
+func (m ForthMap) Mod(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '%%' operands (%v and %v)", m, other)
+	return nil
+}
+
+func (m ForthMap) And(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'and' operands (%v and %v)", m, other)
+	return nil
+}
+
+func (m ForthMap) Or(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'or' operands (%v and %v)", m, other)
+	return nil
+}
+
+func (m ForthMap) Xor(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'xor' operands (%v and %v)", m, other)
+	return nil
+}
+
+func (m ForthMap) Not() Variant {
+	Raise(ErrTypeMismatch, "Invalid 'not' operand (%v)", m)
+	return nil
+}
+
+func (m ForthMap) Eq(other Variant) Variant {
+	switch otherCast := other.(type) {
+	case ForthMap:
+		return ForthBool(m.equals(otherCast))
+	case ForthNil:
+		return ForthBool(false)
+	default:
+		Raise(ErrTypeMismatch, "Invalid '==' operands (%v and %v)", m, other)
+		return nil
+	}
+}
+
+func (m ForthMap) Ne(other Variant) Variant {
+	switch otherCast := other.(type) {
+	case ForthMap:
+		return ForthBool(!m.equals(otherCast))
+	case ForthNil:
+		return ForthBool(true)
+	default:
+		Raise(ErrTypeMismatch, "Invalid '!=' operands (%v and %v)", m, other)
+		return nil
+	}
+}
+
+func (m ForthMap) Lt(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '<' operands (%v and %v)", m, other)
+	return nil
+}
+
+func (m ForthMap) Gt(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '>' operands (%v and %v)", m, other)
+	return nil
+}
+
+func (m ForthMap) Le(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '<=' operands (%v and %v)", m, other)
+	return nil
+}
+
+func (m ForthMap) Ge(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '>=' operands (%v and %v)", m, other)
+	return nil
+}
+
+func (m ForthMap) AsBool() bool {
+	return len(m.entries) != 0
+}
+
+func (m ForthMap) TypeName() string {
+	return "map"
+}
+
+func (m ForthMap) ToInt() (ForthInt, error) {
+	return 0, conversionError(m, "int")
+}
+
+func (m ForthMap) ToFloat() (ForthFloat, error) {
+	return 0, conversionError(m, "float")
+}
+
+func (m ForthMap) ToString() ForthString {
+	return ForthString(m.String())
+}
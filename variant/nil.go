@@ -0,0 +1,102 @@
+package variant
+
+type ForthNil struct{}
+
+func (n ForthNil) String() string {
+	return "nil"
+}
+
+func (n ForthNil) Add(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '+' operands (%v and %v); nil has no arithmetic", n, other)
+	return nil
+}
+
+func (n ForthNil) Sub(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '-' operands (%v and %v); nil has no arithmetic", n, other)
+	return nil
+}
+
+func (n ForthNil) Mul(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '*' operands (%v and %v); nil has no arithmetic", n, other)
+	return nil
+}
+
+func (n ForthNil) Div(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '/' operands (%v and %v); nil has no arithmetic", n, other)
+	return nil
+}
+
+func (n ForthNil) Mod(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '%%' operands (%v and %v); nil has no arithmetic", n, other)
+	return nil
+}
+
+func (n ForthNil) And(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'and' operands (%v and %v); nil has no logic operations", n, other)
+	return nil
+}
+
+func (n ForthNil) Or(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'or' operands (%v and %v); nil has no logic operations", n, other)
+	return nil
+}
+
+func (n ForthNil) Xor(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'xor' operands (%v and %v); nil has no logic operations", n, other)
+	return nil
+}
+
+func (n ForthNil) Not() Variant {
+	Raise(ErrTypeMismatch, "Invalid 'not' operand (%v); nil has no logic operations", n)
+	return nil
+}
+
+func (n ForthNil) Eq(other Variant) Variant {
+	var _, isNil = other.(ForthNil)
+	return ForthBool(isNil)
+}
+
+func (n ForthNil) Ne(other Variant) Variant {
+	var _, isNil = other.(ForthNil)
+	return ForthBool(!isNil)
+}
+
+func (n ForthNil) Lt(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '<' operands (%v and %v); nil is not ordered", n, other)
+	return nil
+}
+
+func (n ForthNil) Gt(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '>' operands (%v and %v); nil is not ordered", n, other)
+	return nil
+}
+
+func (n ForthNil) Le(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '<=' operands (%v and %v); nil is not ordered", n, other)
+	return nil
+}
+
+func (n ForthNil) Ge(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '>=' operands (%v and %v); nil is not ordered", n, other)
+	return nil
+}
+
+func (n ForthNil) AsBool() bool {
+	return false
+}
+
+func (n ForthNil) TypeName() string {
+	return "nil"
+}
+
+func (n ForthNil) ToInt() (ForthInt, error) {
+	return 0, conversionError(n, "int")
+}
+
+func (n ForthNil) ToFloat() (ForthFloat, error) {
+	return 0, conversionError(n, "float")
+}
+
+func (n ForthNil) ToString() ForthString {
+	return ForthString(n.String())
+}
@@ -0,0 +1,152 @@
+package variant
+
+import "math"
+
+// Mixed bool, int and float operands are promoted to the higher of the two
+// ranks (bool < int < float) and the operation is applied at that rank.
+// Arithmetic and ordering never happen on bools, so bool operands are raised
+// to at least int for those: true true + is 2 and false true < is true.
+// Logic operations are logical on bools, bitwise on ints and undefined on
+// floats.
+type numericRank int
+
+const (
+	rankBool numericRank = iota
+	rankInt
+	rankFloat
+)
+
+type promotedOperation struct {
+	onBools  func(lhs ForthBool, rhs ForthBool) Variant
+	onInts   func(lhs ForthInt, rhs ForthInt) Variant
+	onFloats func(lhs ForthFloat, rhs ForthFloat) Variant
+}
+
+var promotionTable = map[string]promotedOperation{
+	"+": {
+		onInts:   func(lhs ForthInt, rhs ForthInt) Variant { return lhs + rhs },
+		onFloats: func(lhs ForthFloat, rhs ForthFloat) Variant { return lhs + rhs },
+	},
+	"-": {
+		onInts:   func(lhs ForthInt, rhs ForthInt) Variant { return lhs - rhs },
+		onFloats: func(lhs ForthFloat, rhs ForthFloat) Variant { return lhs - rhs },
+	},
+	"*": {
+		onInts:   func(lhs ForthInt, rhs ForthInt) Variant { return lhs * rhs },
+		onFloats: func(lhs ForthFloat, rhs ForthFloat) Variant { return lhs * rhs },
+	},
+	"/": {
+		onInts: func(lhs ForthInt, rhs ForthInt) Variant {
+			if rhs == 0 {
+				Raise(ErrDivisionByZero, "Division by zero (%v and %v)", lhs, rhs)
+			}
+
+			return lhs / rhs
+		},
+		onFloats: func(lhs ForthFloat, rhs ForthFloat) Variant { return lhs / rhs },
+	},
+	"%": {
+		onInts: func(lhs ForthInt, rhs ForthInt) Variant {
+			if rhs == 0 {
+				Raise(ErrDivisionByZero, "Division by zero (%v and %v)", lhs, rhs)
+			}
+
+			return lhs % rhs
+		},
+		onFloats: func(lhs ForthFloat, rhs ForthFloat) Variant { return ForthFloat(math.Mod(float64(lhs), float64(rhs))) },
+	},
+
+	"and": {
+		onBools: func(lhs ForthBool, rhs ForthBool) Variant { return lhs && rhs },
+		onInts:  func(lhs ForthInt, rhs ForthInt) Variant { return lhs & rhs },
+	},
+	"or": {
+		onBools: func(lhs ForthBool, rhs ForthBool) Variant { return lhs || rhs },
+		onInts:  func(lhs ForthInt, rhs ForthInt) Variant { return lhs | rhs },
+	},
+	"xor": {
+		onBools: func(lhs ForthBool, rhs ForthBool) Variant { return ForthBool(lhs != rhs) },
+		onInts:  func(lhs ForthInt, rhs ForthInt) Variant { return lhs ^ rhs },
+	},
+
+	"==": {
+		onBools:  func(lhs ForthBool, rhs ForthBool) Variant { return ForthBool(lhs == rhs) },
+		onInts:   func(lhs ForthInt, rhs ForthInt) Variant { return ForthBool(lhs == rhs) },
+		onFloats: func(lhs ForthFloat, rhs ForthFloat) Variant { return ForthBool(lhs == rhs) },
+	},
+	"!=": {
+		onBools:  func(lhs ForthBool, rhs ForthBool) Variant { return ForthBool(lhs != rhs) },
+		onInts:   func(lhs ForthInt, rhs ForthInt) Variant { return ForthBool(lhs != rhs) },
+		onFloats: func(lhs ForthFloat, rhs ForthFloat) Variant { return ForthBool(lhs != rhs) },
+	},
+	"<": {
+		onInts:   func(lhs ForthInt, rhs ForthInt) Variant { return ForthBool(lhs < rhs) },
+		onFloats: func(lhs ForthFloat, rhs ForthFloat) Variant { return ForthBool(lhs < rhs) },
+	},
+	">": {
+		onInts:   func(lhs ForthInt, rhs ForthInt) Variant { return ForthBool(lhs > rhs) },
+		onFloats: func(lhs ForthFloat, rhs ForthFloat) Variant { return ForthBool(lhs > rhs) },
+	},
+	"<=": {
+		onInts:   func(lhs ForthInt, rhs ForthInt) Variant { return ForthBool(lhs <= rhs) },
+		onFloats: func(lhs ForthFloat, rhs ForthFloat) Variant { return ForthBool(lhs <= rhs) },
+	},
+	">=": {
+		onInts:   func(lhs ForthInt, rhs ForthInt) Variant { return ForthBool(lhs >= rhs) },
+		onFloats: func(lhs ForthFloat, rhs ForthFloat) Variant { return ForthBool(lhs >= rhs) },
+	},
+}
+
+func rankOf(value Variant) (numericRank, bool) {
+	switch value.(type) {
+	case ForthBool:
+		return rankBool, true
+	case ForthInt:
+		return rankInt, true
+	case ForthFloat:
+		return rankFloat, true
+	default:
+		return 0, false
+	}
+}
+
+// applyPromotion reports false when either operand is outside the
+// bool/int/float table, leaving the caller to handle the other types.
+func applyPromotion(operator string, lhs Variant, rhs Variant) (Variant, bool) {
+	var lhsRank, lhsRanked = rankOf(lhs)
+	var rhsRank, rhsRanked = rankOf(rhs)
+	if !lhsRanked || !rhsRanked {
+		return nil, false
+	}
+
+	var operation = promotionTable[operator]
+	var rank = max(lhsRank, rhsRank)
+	if rank == rankBool && operation.onBools == nil {
+		rank = rankInt
+	}
+
+	switch {
+	case rank == rankBool:
+		return operation.onBools(lhs.(ForthBool), rhs.(ForthBool)), true
+	case rank == rankInt && operation.onInts != nil:
+		var lhsInt, _ = lhs.ToInt()
+		var rhsInt, _ = rhs.ToInt()
+		return operation.onInts(lhsInt, rhsInt), true
+	case rank == rankFloat && operation.onFloats != nil:
+		var lhsFloat, _ = lhs.ToFloat()
+		var rhsFloat, _ = rhs.ToFloat()
+		return operation.onFloats(lhsFloat, rhsFloat), true
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '%s' operands (%v and %v)", operator, lhs, rhs)
+	return nil, true
+}
+
+func promoted(operator string, lhs Variant, rhs Variant) Variant {
+	if result, handled := applyPromotion(operator, lhs, rhs); handled {
+		return result
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '%s' operands (%v and %v)", operator, lhs, rhs)
+	return nil
+}
@@ -0,0 +1,230 @@
+package variant
+
+import (
+	"math/big"
+	"strings"
+)
+
+type ForthRational struct {
+	value *big.Rat
+}
+
+func NewForthRational(value *big.Rat) ForthRational {
+	return ForthRational{new(big.Rat).Set(value)}
+}
+
+func ParseForthRational(text string) (ForthRational, bool) {
+	var body, hasSuffix = strings.CutSuffix(text, "r")
+	if !hasSuffix || body == "" {
+		return ForthRational{}, false
+	}
+
+	if value, ok := new(big.Rat).SetString(body); ok {
+		return ForthRational{value}, true
+	}
+
+	return ForthRational{}, false
+}
+
+func (i ForthInt) toRational() ForthRational {
+	return ForthRational{new(big.Rat).SetInt64(int64(i))}
+}
+
+func toRat(value Variant) (*big.Rat, bool) {
+	switch valueCast := value.(type) {
+	case ForthInt:
+		return new(big.Rat).SetInt64(int64(valueCast)), true
+	case ForthBigInt:
+		return new(big.Rat).SetInt(valueCast.value), true
+	case ForthRational:
+		return valueCast.value, true
+	case ForthDecimal:
+		return valueCast.Rat(), true
+	default:
+		return nil, false
+	}
+}
+
+func (r ForthRational) Rat() *big.Rat {
+	return new(big.Rat).Set(r.value)
+}
+
+func (r ForthRational) String() string {
+	return r.value.RatString()
+}
+
+func (r ForthRational) Float() ForthFloat {
+	var asFloat, _ = r.value.Float64()
+	return ForthFloat(asFloat)
+}
+
+func (r ForthRational) compare(operator string, other Variant) (result int, ordered bool) {
+	if otherRat, isExact := toRat(other); isExact {
+		return r.value.Cmp(otherRat), true
+	} else if otherFloat, isFloat := other.(ForthFloat); isFloat {
+		return compareFloats(r.Float(), otherFloat)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '%s' operands (%v and %v)", operator, r, other)
+	return 0, false
+}
+
+func (r ForthRational) Add(other Variant) Variant {
+	if otherRat, isExact := toRat(other); isExact {
+		return ForthRational{new(big.Rat).Add(r.value, otherRat)}
+	} else if otherFloat, isFloat := other.(ForthFloat); isFloat {
+		return r.Float() + otherFloat
+	} else if otherComplex, isComplex := other.(ForthComplex); isComplex {
+		return promoteComplex(r).Add(otherComplex)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '+' operands (%v and %v)", r, other)
+	return nil
+}
+
+func (r ForthRational) Sub(other Variant) Variant {
+	if otherRat, isExact := toRat(other); isExact {
+		return ForthRational{new(big.Rat).Sub(r.value, otherRat)}
+	} else if otherFloat, isFloat := other.(ForthFloat); isFloat {
+		return r.Float() - otherFloat
+	} else if otherComplex, isComplex := other.(ForthComplex); isComplex {
+		return promoteComplex(r).Sub(otherComplex)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '-' operands (%v and %v)", r, other)
+	return nil
+}
+
+func (r ForthRational) Mul(other Variant) Variant {
+	if otherRat, isExact := toRat(other); isExact {
+		return ForthRational{new(big.Rat).Mul(r.value, otherRat)}
+	} else if otherFloat, isFloat := other.(ForthFloat); isFloat {
+		return r.Float() * otherFloat
+	} else if otherComplex, isComplex := other.(ForthComplex); isComplex {
+		return promoteComplex(r).Mul(otherComplex)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '*' operands (%v and %v)", r, other)
+	return nil
+}
+
+func (r ForthRational) Div(other Variant) Variant {
+	if otherRat, isExact := toRat(other); isExact {
+		if otherRat.Sign() == 0 {
+			Raise(ErrDivisionByZero, "Division by zero (%v and %v)", r, other)
+		}
+
+		return ForthRational{new(big.Rat).Quo(r.value, otherRat)}
+	} else if otherFloat, isFloat := other.(ForthFloat); isFloat {
+		return r.Float() / otherFloat
+	} else if otherComplex, isComplex := other.(ForthComplex); isComplex {
+		return promoteComplex(r).Div(otherComplex)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '/' operands (%v and %v)", r, other)
+	return nil
+}
+
+func (r ForthRational) Mod(other Variant) Variant {
+	if otherRat, isExact := toRat(other); isExact {
+		if otherRat.Sign() == 0 {
+			Raise(ErrDivisionByZero, "Division by zero (%v and %v)", r, other)
+		}
+
+		var quotient = new(big.Rat).Quo(r.value, otherRat)
+		var truncated = new(big.Int).Quo(quotient.Num(), quotient.Denom())
+		var product = new(big.Rat).Mul(otherRat, new(big.Rat).SetInt(truncated))
+		return ForthRational{new(big.Rat).Sub(r.value, product)}
+	} else if otherFloat, isFloat := other.(ForthFloat); isFloat {
+		return r.Float().Mod(otherFloat)
+	}
+
+	Raise(ErrTypeMismatch, "Invalid '%%' operands (%v and %v)", r, other)
+	return nil
+}
+
+func (r ForthRational) And(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'and' operands (%v and %v)", r, other)
+	return nil
+}
+
+func (r ForthRational) Or(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'or' operands (%v and %v)", r, other)
+	return nil
+}
+
+func (r ForthRational) Xor(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'xor' operands (%v and %v)", r, other)
+	return nil
+}
+
+func (r ForthRational) Not() Variant {
+	Raise(ErrTypeMismatch, "Invalid 'not' operand (%v)", r)
+	return nil
+}
+
+func (r ForthRational) Eq(other Variant) Variant {
+	if _, isNil := other.(ForthNil); isNil {
+		return ForthBool(false)
+	}
+
+	if otherComplex, isComplex := other.(ForthComplex); isComplex {
+		return otherComplex.Eq(r)
+	}
+
+	var result, ordered = r.compare("==", other)
+	return ForthBool(ordered && result == 0)
+}
+
+func (r ForthRational) Ne(other Variant) Variant {
+	if _, isNil := other.(ForthNil); isNil {
+		return ForthBool(true)
+	}
+
+	if otherComplex, isComplex := other.(ForthComplex); isComplex {
+		return otherComplex.Ne(r)
+	}
+
+	var result, ordered = r.compare("!=", other)
+	return ForthBool(!ordered || result != 0)
+}
+
+func (r ForthRational) Lt(other Variant) Variant {
+	var result, ordered = r.compare("<", other)
+	return ForthBool(ordered && result < 0)
+}
+
+func (r ForthRational) Gt(other Variant) Variant {
+	var result, ordered = r.compare(">", other)
+	return ForthBool(ordered && result > 0)
+}
+
+func (r ForthRational) Le(other Variant) Variant {
+	var result, ordered = r.compare("<=", other)
+	return ForthBool(ordered && result <= 0)
+}
+
+func (r ForthRational) Ge(other Variant) Variant {
+	var result, ordered = r.compare(">=", other)
+	return ForthBool(ordered && result >= 0)
+}
+
+func (r ForthRational) AsBool() bool {
+	return r.value.Sign() != 0
+}
+
+func (r ForthRational) TypeName() string {
+	return "rational"
+}
+
+func (r ForthRational) ToInt() (ForthInt, error) {
+	return truncateRat(r.value, r)
+}
+
+func (r ForthRational) ToFloat() (ForthFloat, error) {
+	return r.Float(), nil
+}
+
+func (r ForthRational) ToString() ForthString {
+	return ForthString(r.String())
+}
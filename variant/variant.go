@@ -1,8 +1,11 @@
 package variant
 
 import (
-	"log"
+	"errors"
 	"math"
+	"math/big"
+	"strconv"
+	"strings"
 )
 
 type Variant interface {
@@ -25,145 +28,49 @@ type Variant interface {
 	Ge(other Variant) Variant
 
 	AsBool() bool
+
+	TypeName() string
+	ToInt() (ForthInt, error)
+	ToFloat() (ForthFloat, error)
+	ToString() ForthString
 }
 
 type ForthBool bool
 type ForthInt int64
 type ForthFloat float64
 type ForthString string
+type ForthXt string
 
 func (b ForthBool) Add(other Variant) Variant {
-	switch otherCast := other.(type) {
-	case ForthBool:
-		return b || otherCast
-	case ForthInt:
-		var asInt ForthInt
-		if b {
-			asInt = 1
-		} else {
-			asInt = 0
-		}
-
-		return asInt + otherCast
-	case ForthFloat:
-		var asFloat ForthFloat
-		if b {
-			asFloat = 1.0
-		} else {
-			asFloat = 0.0
-		}
-
-		return asFloat + otherCast
-	default:
-		log.Fatalf("Error: Invalid '+' operands (%v and %v)", b, other)
-		return nil
-	}
+	return promoted("+", b, other)
 }
 
 func (b ForthBool) Sub(other Variant) Variant {
-	switch otherCast := other.(type) {
-	case ForthInt:
-		var asInt ForthInt
-		if b {
-			asInt = 1
-		} else {
-			asInt = 0
-		}
-
-		return asInt - otherCast
-	case ForthFloat:
-		var asFloat ForthFloat
-		if b {
-			asFloat = 1.0
-		} else {
-			asFloat = 0.0
-		}
-
-		return asFloat - otherCast
-	default:
-		log.Fatalf("Error: Invalid '-' operands (%v and %v)", b, other)
-		return nil
-	}
+	return promoted("-", b, other)
 }
 
 func (b ForthBool) Mul(other Variant) Variant {
-	switch otherCast := other.(type) {
-	case ForthBool:
-		return b && otherCast
-	case ForthInt:
-		var asInt ForthInt
-		if b {
-			asInt = 1
-		} else {
-			asInt = 0
-		}
-
-		return asInt * otherCast
-	case ForthFloat:
-		var asFloat ForthFloat
-		if b {
-			asFloat = 1.0
-		} else {
-			asFloat = 0.0
-		}
-
-		return asFloat * otherCast
-	default:
-		log.Fatalf("Error: Invalid '*' operands (%v and %v)", b, other)
-		return nil
-	}
+	return promoted("*", b, other)
 }
 
 func (b ForthBool) Div(other Variant) Variant {
-	log.Fatalf("Error: Invalid '/' operands (%v and %v)", b, other)
-	return nil
+	return promoted("/", b, other)
 }
 
 func (b ForthBool) Mod(other Variant) Variant {
-	log.Fatalf("Error: Invalid '%%' operands (%v and %v)", b, other)
-	return nil
+	return promoted("%", b, other)
 }
 
 func (b ForthBool) And(other Variant) Variant {
-	switch otherCast := other.(type) {
-	case ForthBool:
-		return b && otherCast
-	case ForthInt:
-		return b && (otherCast != 0)
-	case ForthFloat:
-		return b && (otherCast != 0.0)
-	default:
-		log.Fatalf("Error: Invalid 'and' operands (%v and %v)", b, other)
-		return nil
-	}
+	return promoted("and", b, other)
 }
 
 func (b ForthBool) Or(other Variant) Variant {
-	switch otherCast := other.(type) {
-	case ForthBool:
-		return b || otherCast
-	case ForthInt:
-		return b || (otherCast != 0)
-	case ForthFloat:
-		return b || (otherCast != 0.0)
-	default:
-		log.Fatalf("Error: Invalid 'or' operands (%v and %v)", b, other)
-		return nil
-	}
+	return promoted("or", b, other)
 }
 
 func (b ForthBool) Xor(other Variant) Variant {
-	switch otherCast := other.(type) {
-	case ForthBool:
-		return ForthBool(b != otherCast)
-	case ForthInt:
-		return ForthBool(b != (otherCast != 0))
-	case ForthFloat:
-		return ForthBool(b != (otherCast != 0.0))
-	default:
-		log.Fatalf("Error: Invalid 'xor' operands (%v and %v)", b, other)
-		return nil
-	}
+	return promoted("xor", b, other)
 }
 
 func (b ForthBool) Not() Variant {
@@ -171,155 +78,213 @@ func (b ForthBool) Not() Variant {
 }
 
 func (b ForthBool) Eq(other Variant) Variant {
-	switch otherCast := other.(type) {
-	case ForthBool:
-		return ForthBool(b == otherCast)
-	case ForthInt:
-		return ForthBool(b == (otherCast != 0))
-	case ForthFloat:
-		return ForthBool(b == (otherCast != 0.0))
-	default:
-		log.Fatalf("Error: Invalid '==' operands (%v and %v)", b, other)
-		return nil
+	if _, isNil := other.(ForthNil); isNil {
+		return ForthBool(false)
 	}
+
+	return promoted("==", b, other)
 }
 
 func (b ForthBool) Ne(other Variant) Variant {
-	return b.Xor(other)
+	if _, isNil := other.(ForthNil); isNil {
+		return ForthBool(true)
+	}
+
+	return promoted("!=", b, other)
 }
 
 func (b ForthBool) Lt(other Variant) Variant {
-	log.Fatalf("Error: Invalid '<' operands (%v and %v)", b, other)
-	return nil
+	return promoted("<", b, other)
 }
 
 func (b ForthBool) Gt(other Variant) Variant {
-	log.Fatalf("Error: Invalid '>' operands (%v and %v)", b, other)
-	return nil
+	return promoted(">", b, other)
 }
 
 func (b ForthBool) Le(other Variant) Variant {
-	log.Fatalf("Error: Invalid '<=' operands (%v and %v)", b, other)
-	return nil
+	return promoted("<=", b, other)
 }
 
 func (b ForthBool) Ge(other Variant) Variant {
-	log.Fatalf("Error: Invalid '>=' operands (%v and %v)", b, other)
-	return nil
+	return promoted(">=", b, other)
 }
 
 func (b ForthBool) AsBool() bool {
 	return bool(b)
 }
 
+func (b ForthBool) TypeName() string {
+	return "bool"
+}
+
+func (b ForthBool) ToInt() (ForthInt, error) {
+	if b {
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+func (b ForthBool) ToFloat() (ForthFloat, error) {
+	if b {
+		return 1.0, nil
+	}
+
+	return 0.0, nil
+}
+
+func (b ForthBool) ToString() ForthString {
+	return ForthString(strconv.FormatBool(bool(b)))
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////
 
 func (i ForthInt) Add(other Variant) Variant {
-	switch otherCast := other.(type) {
-	case ForthBool:
-		var result = i
-		if otherCast {
-			result++
-		}
-
+	if result, handled := applyPromotion("+", i, other); handled {
 		return result
-	case ForthInt:
-		return i + otherCast
-	case ForthFloat:
-		return ForthFloat(i) + otherCast
+	}
+
+	switch otherCast := other.(type) {
+	case ForthBigInt:
+		return FromBigInt(new(big.Int).Add(i.toBigInt(), otherCast.value))
+	case ForthRational:
+		return i.toRational().Add(otherCast)
+	case ForthDecimal:
+		return i.toDecimal().Add(otherCast)
+	case ForthComplex:
+		return ForthComplex(complex(float64(i), 0)).Add(otherCast)
+	case ForthChar:
+		return otherCast.Add(i)
 	default:
-		log.Fatalf("Error: Invalid '+' operands (%v and %v)", i, other)
+		Raise(ErrTypeMismatch, "Invalid '+' operands (%v and %v)", i, other)
 		return nil
 	}
 }
 
 func (i ForthInt) Sub(other Variant) Variant {
-	switch otherCast := other.(type) {
-	case ForthBool:
-		var result = i
-		if otherCast {
-			result--
-		}
-
+	if result, handled := applyPromotion("-", i, other); handled {
 		return result
-	case ForthInt:
-		return i - otherCast
-	case ForthFloat:
-		return ForthFloat(i) - otherCast
+	}
+
+	switch otherCast := other.(type) {
+	case ForthBigInt:
+		return FromBigInt(new(big.Int).Sub(i.toBigInt(), otherCast.value))
+	case ForthRational:
+		return i.toRational().Sub(otherCast)
+	case ForthDecimal:
+		return i.toDecimal().Sub(otherCast)
+	case ForthComplex:
+		return ForthComplex(complex(float64(i), 0)).Sub(otherCast)
 	default:
-		log.Fatalf("Error: Invalid '-' operands (%v and %v)", i, other)
+		Raise(ErrTypeMismatch, "Invalid '-' operands (%v and %v)", i, other)
 		return nil
 	}
 }
 
 func (i ForthInt) Mul(other Variant) Variant {
+	if result, handled := applyPromotion("*", i, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthBool:
-		if otherCast {
-			return i
-		} else {
-			return ForthInt(0)
-		}
-	case ForthInt:
-		return i * otherCast
-	case ForthFloat:
-		return ForthFloat(i) * otherCast
+	case ForthBigInt:
+		return FromBigInt(new(big.Int).Mul(i.toBigInt(), otherCast.value))
+	case ForthRational:
+		return i.toRational().Mul(otherCast)
+	case ForthDecimal:
+		return i.toDecimal().Mul(otherCast)
+	case ForthComplex:
+		return ForthComplex(complex(float64(i), 0)).Mul(otherCast)
 	default:
-		log.Fatalf("Error: Invalid '*' operands (%v and %v)", i, other)
+		Raise(ErrTypeMismatch, "Invalid '*' operands (%v and %v)", i, other)
 		return nil
 	}
 }
 
 func (i ForthInt) Div(other Variant) Variant {
+	if result, handled := applyPromotion("/", i, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return i / otherCast
-	case ForthFloat:
-		return ForthFloat(i) / otherCast
+	case ForthBigInt:
+		if otherCast.value.Sign() == 0 {
+			Raise(ErrDivisionByZero, "Division by zero (%v and %v)", i, other)
+		}
+
+		return FromBigInt(new(big.Int).Quo(i.toBigInt(), otherCast.value))
+	case ForthRational:
+		return i.toRational().Div(otherCast)
+	case ForthDecimal:
+		return i.toDecimal().Div(otherCast)
+	case ForthComplex:
+		return ForthComplex(complex(float64(i), 0)).Div(otherCast)
 	default:
-		log.Fatalf("Error: Invalid '/' operands (%v and %v)", i, other)
+		Raise(ErrTypeMismatch, "Invalid '/' operands (%v and %v)", i, other)
 		return nil
 	}
 }
 
 func (i ForthInt) Mod(other Variant) Variant {
+	if result, handled := applyPromotion("%", i, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return i % otherCast
-	case ForthFloat:
-		return ForthFloat(math.Mod(float64(i), float64(otherCast)))
+	case ForthBigInt:
+		if otherCast.value.Sign() == 0 {
+			Raise(ErrDivisionByZero, "Division by zero (%v and %v)", i, other)
+		}
+
+		return FromBigInt(new(big.Int).Rem(i.toBigInt(), otherCast.value))
+	case ForthRational:
+		return i.toRational().Mod(otherCast)
+	case ForthDecimal:
+		return i.toDecimal().Mod(otherCast)
 	default:
-		log.Fatalf("Error: Invalid '%%' operands (%v and %v)", i, other)
+		Raise(ErrTypeMismatch, "Invalid '%%' operands (%v and %v)", i, other)
 		return nil
 	}
 }
 
 func (i ForthInt) And(other Variant) Variant {
+	if result, handled := applyPromotion("and", i, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return i & otherCast
+	case ForthBigInt:
+		return FromBigInt(new(big.Int).And(i.toBigInt(), otherCast.value))
 	default:
-		log.Fatalf("Error: Invalid 'and' operands (%v and %v)", i, other)
+		Raise(ErrTypeMismatch, "Invalid 'and' operands (%v and %v)", i, other)
 		return nil
 	}
 }
 
 func (i ForthInt) Or(other Variant) Variant {
+	if result, handled := applyPromotion("or", i, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return i | otherCast
+	case ForthBigInt:
+		return FromBigInt(new(big.Int).Or(i.toBigInt(), otherCast.value))
 	default:
-		log.Fatalf("Error: Invalid 'or' operands (%v and %v)", i, other)
+		Raise(ErrTypeMismatch, "Invalid 'or' operands (%v and %v)", i, other)
 		return nil
 	}
 }
 
 func (i ForthInt) Xor(other Variant) Variant {
+	if result, handled := applyPromotion("xor", i, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return i ^ otherCast
+	case ForthBigInt:
+		return FromBigInt(new(big.Int).Xor(i.toBigInt(), otherCast.value))
 	default:
-		log.Fatalf("Error: Invalid 'xor' operands (%v and %v)", i, other)
+		Raise(ErrTypeMismatch, "Invalid 'xor' operands (%v and %v)", i, other)
 		return nil
 	}
 }
@@ -329,73 +294,129 @@ func (i ForthInt) Not() Variant {
 }
 
 func (i ForthInt) Eq(other Variant) Variant {
+	if result, handled := applyPromotion("==", i, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return ForthBool(i == otherCast)
-	case ForthFloat:
-		return ForthBool(i == ForthInt(otherCast))
+	case ForthBigInt:
+		return ForthBool(i.toBigInt().Cmp(otherCast.value) == 0)
+	case ForthRational:
+		return i.toRational().Eq(otherCast)
+	case ForthDecimal:
+		return i.toDecimal().Eq(otherCast)
+	case ForthComplex:
+		return ForthComplex(complex(float64(i), 0)).Eq(otherCast)
+	case ForthNil:
+		return ForthBool(false)
+	case ForthChar:
+		return otherCast.Eq(i)
 	default:
-		log.Fatalf("Error: Invalid '==' operands (%v and %v)", i, other)
+		Raise(ErrTypeMismatch, "Invalid '==' operands (%v and %v)", i, other)
 		return nil
 	}
 }
 
 func (i ForthInt) Ne(other Variant) Variant {
+	if result, handled := applyPromotion("!=", i, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return ForthBool(i != otherCast)
-	case ForthFloat:
-		return ForthBool(i != ForthInt(otherCast))
+	case ForthBigInt:
+		return ForthBool(i.toBigInt().Cmp(otherCast.value) != 0)
+	case ForthRational:
+		return i.toRational().Ne(otherCast)
+	case ForthDecimal:
+		return i.toDecimal().Ne(otherCast)
+	case ForthComplex:
+		return ForthComplex(complex(float64(i), 0)).Ne(otherCast)
+	case ForthNil:
+		return ForthBool(true)
+	case ForthChar:
+		return otherCast.Ne(i)
 	default:
-		log.Fatalf("Error: Invalid '!=' operands (%v and %v)", i, other)
+		Raise(ErrTypeMismatch, "Invalid '!=' operands (%v and %v)", i, other)
 		return nil
 	}
 }
 
 func (i ForthInt) Lt(other Variant) Variant {
+	if result, handled := applyPromotion("<", i, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return ForthBool(i < otherCast)
-	case ForthFloat:
-		return ForthBool(i < ForthInt(otherCast))
+	case ForthBigInt:
+		return ForthBool(i.toBigInt().Cmp(otherCast.value) < 0)
+	case ForthRational:
+		return i.toRational().Lt(otherCast)
+	case ForthDecimal:
+		return i.toDecimal().Lt(otherCast)
+	case ForthChar:
+		return otherCast.Gt(i)
 	default:
-		log.Fatalf("Error: Invalid '<' operands (%v and %v)", i, other)
+		Raise(ErrTypeMismatch, "Invalid '<' operands (%v and %v)", i, other)
 		return nil
 	}
 }
 
 func (i ForthInt) Gt(other Variant) Variant {
+	if result, handled := applyPromotion(">", i, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return ForthBool(i > otherCast)
-	case ForthFloat:
-		return ForthBool(i > ForthInt(otherCast))
+	case ForthBigInt:
+		return ForthBool(i.toBigInt().Cmp(otherCast.value) > 0)
+	case ForthRational:
+		return i.toRational().Gt(otherCast)
+	case ForthDecimal:
+		return i.toDecimal().Gt(otherCast)
+	case ForthChar:
+		return otherCast.Lt(i)
 	default:
-		log.Fatalf("Error: Invalid '>' operands (%v and %v)", i, other)
+		Raise(ErrTypeMismatch, "Invalid '>' operands (%v and %v)", i, other)
 		return nil
 	}
 }
 
 func (i ForthInt) Le(other Variant) Variant {
+	if result, handled := applyPromotion("<=", i, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return ForthBool(i <= otherCast)
-	case ForthFloat:
-		return ForthBool(i <= ForthInt(otherCast))
+	case ForthBigInt:
+		return ForthBool(i.toBigInt().Cmp(otherCast.value) <= 0)
+	case ForthRational:
+		return i.toRational().Le(otherCast)
+	case ForthDecimal:
+		return i.toDecimal().Le(otherCast)
+	case ForthChar:
+		return otherCast.Ge(i)
 	default:
-		log.Fatalf("Error: Invalid '<=' operands (%v and %v)", i, other)
+		Raise(ErrTypeMismatch, "Invalid '<=' operands (%v and %v)", i, other)
 		return nil
 	}
 }
 
 func (i ForthInt) Ge(other Variant) Variant {
+	if result, handled := applyPromotion(">=", i, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return ForthBool(i >= otherCast)
-	case ForthFloat:
-		return ForthBool(i >= ForthInt(otherCast))
+	case ForthBigInt:
+		return ForthBool(i.toBigInt().Cmp(otherCast.value) >= 0)
+	case ForthRational:
+		return i.toRational().Ge(otherCast)
+	case ForthDecimal:
+		return i.toDecimal().Ge(otherCast)
+	case ForthChar:
+		return otherCast.Le(i)
 	default:
-		log.Fatalf("Error: Invalid '>=' operands (%v and %v)", i, other)
+		Raise(ErrTypeMismatch, "Invalid '>=' operands (%v and %v)", i, other)
 		return nil
 	}
 }
@@ -404,176 +425,251 @@ func (i ForthInt) AsBool() bool {
 	return i != 0
 }
 
+func (i ForthInt) TypeName() string {
+	return "int"
+}
+
+func (i ForthInt) ToInt() (ForthInt, error) {
+	return i, nil
+}
+
+func (i ForthInt) ToFloat() (ForthFloat, error) {
+	return ForthFloat(i), nil
+}
+
+func (i ForthInt) ToString() ForthString {
+	return ForthString(strconv.FormatInt(int64(i), 10))
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////
 
 func (f ForthFloat) Add(other Variant) Variant {
-	switch otherCast := other.(type) {
-	case ForthBool:
-		var result = f
-		if otherCast {
-			result++
-		}
-
+	if result, handled := applyPromotion("+", f, other); handled {
 		return result
-	case ForthInt:
-		return f + ForthFloat(otherCast)
-	case ForthFloat:
-		return f + otherCast
+	}
+
+	switch otherCast := other.(type) {
+	case ForthBigInt:
+		return f + otherCast.Float()
+	case ForthRational:
+		return f + otherCast.Float()
+	case ForthDecimal:
+		return f + otherCast.Float()
+	case ForthComplex:
+		return ForthComplex(complex(float64(f), 0)).Add(otherCast)
 	default:
-		log.Fatalf("Error: Invalid '+' operands (%v and %v)", f, other)
+		Raise(ErrTypeMismatch, "Invalid '+' operands (%v and %v)", f, other)
 		return nil
 	}
 }
 
 func (f ForthFloat) Sub(other Variant) Variant {
-	switch otherCast := other.(type) {
-	case ForthBool:
-		var result = f
-		if otherCast {
-			result--
-		}
-
+	if result, handled := applyPromotion("-", f, other); handled {
 		return result
-	case ForthInt:
-		return f - ForthFloat(otherCast)
-	case ForthFloat:
-		return f - otherCast
+	}
+
+	switch otherCast := other.(type) {
+	case ForthBigInt:
+		return f - otherCast.Float()
+	case ForthRational:
+		return f - otherCast.Float()
+	case ForthDecimal:
+		return f - otherCast.Float()
+	case ForthComplex:
+		return ForthComplex(complex(float64(f), 0)).Sub(otherCast)
 	default:
-		log.Fatalf("Error: Invalid '-' operands (%v and %v)", f, other)
+		Raise(ErrTypeMismatch, "Invalid '-' operands (%v and %v)", f, other)
 		return nil
 	}
 }
 
 func (f ForthFloat) Mul(other Variant) Variant {
+	if result, handled := applyPromotion("*", f, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthBool:
-		if otherCast {
-			return f
-		} else {
-			return ForthFloat(0.0)
-		}
-	case ForthInt:
-		return f * ForthFloat(otherCast)
-	case ForthFloat:
-		return f * otherCast
+	case ForthBigInt:
+		return f * otherCast.Float()
+	case ForthRational:
+		return f * otherCast.Float()
+	case ForthDecimal:
+		return f * otherCast.Float()
+	case ForthComplex:
+		return ForthComplex(complex(float64(f), 0)).Mul(otherCast)
 	default:
-		log.Fatalf("Error: Invalid '*' operands (%v and %v)", f, other)
+		Raise(ErrTypeMismatch, "Invalid '*' operands (%v and %v)", f, other)
 		return nil
 	}
 }
 
 func (f ForthFloat) Div(other Variant) Variant {
+	if result, handled := applyPromotion("/", f, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return f / ForthFloat(otherCast)
-	case ForthFloat:
-		return f / otherCast
+	case ForthBigInt:
+		return f / otherCast.Float()
+	case ForthRational:
+		return f / otherCast.Float()
+	case ForthDecimal:
+		return f / otherCast.Float()
+	case ForthComplex:
+		return ForthComplex(complex(float64(f), 0)).Div(otherCast)
 	default:
-		log.Fatalf("Error: Invalid '/' operands (%v and %v)", f, other)
+		Raise(ErrTypeMismatch, "Invalid '/' operands (%v and %v)", f, other)
 		return nil
 	}
 }
 
 func (f ForthFloat) Mod(other Variant) Variant {
+	if result, handled := applyPromotion("%", f, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return ForthFloat(math.Mod(float64(f), float64(otherCast)))
-	case ForthFloat:
-		return ForthFloat(math.Mod(float64(f), float64(otherCast)))
+	case ForthBigInt:
+		return ForthFloat(math.Mod(float64(f), float64(otherCast.Float())))
+	case ForthRational:
+		return ForthFloat(math.Mod(float64(f), float64(otherCast.Float())))
+	case ForthDecimal:
+		return ForthFloat(math.Mod(float64(f), float64(otherCast.Float())))
 	default:
-		log.Fatalf("Error: Invalid '%%' operands (%v and %v)", f, other)
+		Raise(ErrTypeMismatch, "Invalid '%%' operands (%v and %v)", f, other)
 		return nil
 	}
 }
 
 func (f ForthFloat) And(other Variant) Variant {
-	log.Fatalf("Error: Invalid 'and' operands (%v and %v)", f, other)
-	return nil
+	return promoted("and", f, other)
 }
 
 func (f ForthFloat) Or(other Variant) Variant {
-	log.Fatalf("Error: Invalid 'or' operands (%v and %v)", f, other)
-	return nil
+	return promoted("or", f, other)
 }
 
 func (f ForthFloat) Xor(other Variant) Variant {
-	log.Fatalf("Error: Invalid 'xor' operands (%v and %v)", f, other)
-	return nil
+	return promoted("xor", f, other)
 }
 
 func (f ForthFloat) Not() Variant {
-	log.Fatalf("Error: Invalid 'not' operand (%v)", f)
+	Raise(ErrTypeMismatch, "Invalid 'not' operand (%v)", f)
 	return nil
 }
 
 func (f ForthFloat) Eq(other Variant) Variant {
+	if result, handled := applyPromotion("==", f, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return ForthBool(f == ForthFloat(otherCast))
-	case ForthFloat:
-		return ForthBool(f == otherCast)
+	case ForthBigInt:
+		return ForthBool(f == otherCast.Float())
+	case ForthRational:
+		return ForthBool(f == otherCast.Float())
+	case ForthDecimal:
+		return ForthBool(f == otherCast.Float())
+	case ForthComplex:
+		return ForthComplex(complex(float64(f), 0)).Eq(otherCast)
+	case ForthNil:
+		return ForthBool(false)
 	default:
-		log.Fatalf("Error: Invalid '==' operands (%v and %v)", f, other)
+		Raise(ErrTypeMismatch, "Invalid '==' operands (%v and %v)", f, other)
 		return nil
 	}
 }
 
 func (f ForthFloat) Ne(other Variant) Variant {
+	if result, handled := applyPromotion("!=", f, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return ForthBool(f != ForthFloat(otherCast))
-	case ForthFloat:
-		return ForthBool(f != otherCast)
+	case ForthBigInt:
+		return ForthBool(f != otherCast.Float())
+	case ForthRational:
+		return ForthBool(f != otherCast.Float())
+	case ForthDecimal:
+		return ForthBool(f != otherCast.Float())
+	case ForthComplex:
+		return ForthComplex(complex(float64(f), 0)).Ne(otherCast)
+	case ForthNil:
+		return ForthBool(true)
 	default:
-		log.Fatalf("Error: Invalid '!=' operands (%v and %v)", f, other)
+		Raise(ErrTypeMismatch, "Invalid '!=' operands (%v and %v)", f, other)
 		return nil
 	}
 }
 
 func (f ForthFloat) Lt(other Variant) Variant {
+	if result, handled := applyPromotion("<", f, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return ForthBool(f < ForthFloat(otherCast))
-	case ForthFloat:
-		return ForthBool(f < otherCast)
+	case ForthBigInt:
+		return ForthBool(f < otherCast.Float())
+	case ForthRational:
+		return ForthBool(f < otherCast.Float())
+	case ForthDecimal:
+		return ForthBool(f < otherCast.Float())
 	default:
-		log.Fatalf("Error: Invalid '<' operands (%v and %v)", f, other)
+		Raise(ErrTypeMismatch, "Invalid '<' operands (%v and %v)", f, other)
 		return nil
 	}
 }
 
 func (f ForthFloat) Gt(other Variant) Variant {
+	if result, handled := applyPromotion(">", f, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return ForthBool(f > ForthFloat(otherCast))
-	case ForthFloat:
-		return ForthBool(f > otherCast)
+	case ForthBigInt:
+		return ForthBool(f > otherCast.Float())
+	case ForthRational:
+		return ForthBool(f > otherCast.Float())
+	case ForthDecimal:
+		return ForthBool(f > otherCast.Float())
 	default:
-		log.Fatalf("Error: Invalid '>' operands (%v and %v)", f, other)
+		Raise(ErrTypeMismatch, "Invalid '>' operands (%v and %v)", f, other)
 		return nil
 	}
 }
 
 func (f ForthFloat) Le(other Variant) Variant {
+	if result, handled := applyPromotion("<=", f, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return ForthBool(f <= ForthFloat(otherCast))
-	case ForthFloat:
-		return ForthBool(f <= otherCast)
+	case ForthBigInt:
+		return ForthBool(f <= otherCast.Float())
+	case ForthRational:
+		return ForthBool(f <= otherCast.Float())
+	case ForthDecimal:
+		return ForthBool(f <= otherCast.Float())
 	default:
-		log.Fatalf("Error: Invalid '<=' operands (%v and %v)", f, other)
+		Raise(ErrTypeMismatch, "Invalid '<=' operands (%v and %v)", f, other)
 		return nil
 	}
 }
 
 func (f ForthFloat) Ge(other Variant) Variant {
+	if result, handled := applyPromotion(">=", f, other); handled {
+		return result
+	}
+
 	switch otherCast := other.(type) {
-	case ForthInt:
-		return ForthBool(f >= ForthFloat(otherCast))
-	case ForthFloat:
-		return ForthBool(f >= otherCast)
+	case ForthBigInt:
+		return ForthBool(f >= otherCast.Float())
+	case ForthRational:
+		return ForthBool(f >= otherCast.Float())
+	case ForthDecimal:
+		return ForthBool(f >= otherCast.Float())
 	default:
-		log.Fatalf("Error: Invalid '>=' operands (%v and %v)", f, other)
+		Raise(ErrTypeMismatch, "Invalid '>=' operands (%v and %v)", f, other)
 		return nil
 	}
 }
@@ -582,55 +678,73 @@ func (f ForthFloat) AsBool() bool {
 	return f != 0.0
 }
 
+func (f ForthFloat) TypeName() string {
+	return "float"
+}
+
+func (f ForthFloat) ToInt() (ForthInt, error) {
+	return truncateFloat(f)
+}
+
+func (f ForthFloat) ToFloat() (ForthFloat, error) {
+	return f, nil
+}
+
+func (f ForthFloat) ToString() ForthString {
+	return ForthString(strconv.FormatFloat(float64(f), 'g', -1, 64))
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////
 
 func (s ForthString) Add(other Variant) Variant {
 	switch otherCast := other.(type) {
 	case ForthString:
 		return s + otherCast
+	case ForthChar:
+		return s + ForthString(otherCast.String())
 	default:
-		log.Fatalf("Error: Invalid '+' operands (%v and %v)", s, other)
+		Raise(ErrTypeMismatch, "Invalid '+' operands (%v and %v)", s, other)
 		return nil
 	}
 }
 
 func (s ForthString) Sub(other Variant) Variant {
-	log.Fatalf("Error: Invalid '-' operands (%v and %v)", s, other)
+	Raise(ErrTypeMismatch, "Invalid '-' operands (%v and %v)", s, other)
 	return nil
 }
 
 func (s ForthString) Mul(other Variant) Variant {
-	log.Fatalf("Error: Invalid '*' operands (%v and %v)", s, other)
+	Raise(ErrTypeMismatch, "Invalid '*' operands (%v and %v)", s, other)
 	return nil
 }
 
 func (s ForthString) Div(other Variant) Variant {
-	log.Fatalf("Error: Invalid '/' operands (%v and %v)", s, other)
+	Raise(ErrTypeMismatch, "Invalid '/' operands (%v and %v)", s, other)
 	return nil
 }
 
 func (s ForthString) Mod(other Variant) Variant {
-	log.Fatalf("Error: Invalid '%%' operands (%v and %v)", s, other)
+	Raise(ErrTypeMismatch, "Invalid '%%' operands (%v and %v)", s, other)
 	return nil
 }
 
 func (s ForthString) And(other Variant) Variant {
-	log.Fatalf("Error: Invalid 'and' operands (%v and %v)", s, other)
+	Raise(ErrTypeMismatch, "Invalid 'and' operands (%v and %v)", s, other)
 	return nil
 }
 
 func (s ForthString) Or(other Variant) Variant {
-	log.Fatalf("Error: Invalid 'or' operands (%v and %v)", s, other)
+	Raise(ErrTypeMismatch, "Invalid 'or' operands (%v and %v)", s, other)
 	return nil
 }
 
 func (s ForthString) Xor(other Variant) Variant {
-	log.Fatalf("Error: Invalid 'xor' operands (%v and %v)", s, other)
+	Raise(ErrTypeMismatch, "Invalid 'xor' operands (%v and %v)", s, other)
 	return nil
 }
 
 func (s ForthString) Not() Variant {
-	log.Fatalf("Error: Invalid 'not' operand (%v)", s)
+	Raise(ErrTypeMismatch, "Invalid 'not' operand (%v)", s)
 	return nil
 }
 
@@ -638,8 +752,10 @@ func (s ForthString) Eq(other Variant) Variant {
 	switch otherCast := other.(type) {
 	case ForthString:
 		return ForthBool(s == otherCast)
+	case ForthNil:
+		return ForthBool(false)
 	default:
-		log.Fatalf("Error: Invalid '==' operands (%v and %v)", s, other)
+		Raise(ErrTypeMismatch, "Invalid '==' operands (%v and %v)", s, other)
 		return nil
 	}
 }
@@ -648,8 +764,10 @@ func (s ForthString) Ne(other Variant) Variant {
 	switch otherCast := other.(type) {
 	case ForthString:
 		return ForthBool(s != otherCast)
+	case ForthNil:
+		return ForthBool(true)
 	default:
-		log.Fatalf("Error: Invalid '!=' operands (%v and %v)", s, other)
+		Raise(ErrTypeMismatch, "Invalid '!=' operands (%v and %v)", s, other)
 		return nil
 	}
 }
@@ -659,7 +777,7 @@ func (s ForthString) Lt(other Variant) Variant {
 	case ForthString:
 		return ForthBool(s < otherCast)
 	default:
-		log.Fatalf("Error: Invalid '<' operands (%v and %v)", s, other)
+		Raise(ErrTypeMismatch, "Invalid '<' operands (%v and %v)", s, other)
 		return nil
 	}
 }
@@ -669,7 +787,7 @@ func (s ForthString) Gt(other Variant) Variant {
 	case ForthString:
 		return ForthBool(s > otherCast)
 	default:
-		log.Fatalf("Error: Invalid '>' operands (%v and %v)", s, other)
+		Raise(ErrTypeMismatch, "Invalid '>' operands (%v and %v)", s, other)
 		return nil
 	}
 }
@@ -679,7 +797,7 @@ func (s ForthString) Le(other Variant) Variant {
 	case ForthString:
 		return ForthBool(s <= otherCast)
 	default:
-		log.Fatalf("Error: Invalid '<=' operands (%v and %v)", s, other)
+		Raise(ErrTypeMismatch, "Invalid '<=' operands (%v and %v)", s, other)
 		return nil
 	}
 }
@@ -689,7 +807,7 @@ func (s ForthString) Ge(other Variant) Variant {
 	case ForthString:
 		return ForthBool(s >= otherCast)
 	default:
-		log.Fatalf("Error: Invalid '>=' operands (%v and %v)", s, other)
+		Raise(ErrTypeMismatch, "Invalid '>=' operands (%v and %v)", s, other)
 		return nil
 	}
 }
@@ -697,3 +815,142 @@ func (s ForthString) Ge(other Variant) Variant {
 func (s ForthString) AsBool() bool {
 	return len(s) != 0
 }
+
+func (s ForthString) TypeName() string {
+	return "string"
+}
+
+func (s ForthString) ToInt() (ForthInt, error) {
+	var value, err = strconv.ParseInt(strings.TrimSpace(string(s)), 10, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, NewError(ErrResultOutOfRange, "%q is out of integer range", string(s))
+	} else if err != nil {
+		return 0, NewError(ErrInvalidNumericArgument, "%q is not an integer", string(s))
+	}
+
+	return ForthInt(value), nil
+}
+
+func (s ForthString) ToFloat() (ForthFloat, error) {
+	var value, err = strconv.ParseFloat(strings.TrimSpace(string(s)), 64)
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return 0, NewError(ErrInvalidNumericArgument, "%q is not a number", string(s))
+	}
+
+	return ForthFloat(value), nil
+}
+
+func (s ForthString) ToString() ForthString {
+	return s
+}
+
+///////////////////////////////////////////////////////////////////////////////////////////////////
+
+func (x ForthXt) Add(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '+' operands (%v and %v)", x, other)
+	return nil
+}
+
+func (x ForthXt) Sub(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '-' operands (%v and %v)", x, other)
+	return nil
+}
+
+func (x ForthXt) Mul(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '*' operands (%v and %v)", x, other)
+	return nil
+}
+
+func (x ForthXt) Div(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '/' operands (%v and %v)", x, other)
+	return nil
+}
+
+func (x ForthXt) Mod(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '%%' operands (%v and %v)", x, other)
+	return nil
+}
+
+func (x ForthXt) And(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'and' operands (%v and %v)", x, other)
+	return nil
+}
+
+func (x ForthXt) Or(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'or' operands (%v and %v)", x, other)
+	return nil
+}
+
+func (x ForthXt) Xor(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid 'xor' operands (%v and %v)", x, other)
+	return nil
+}
+
+func (x ForthXt) Not() Variant {
+	Raise(ErrTypeMismatch, "Invalid 'not' operand (%v)", x)
+	return nil
+}
+
+func (x ForthXt) Eq(other Variant) Variant {
+	switch otherCast := other.(type) {
+	case ForthXt:
+		return ForthBool(x == otherCast)
+	case ForthNil:
+		return ForthBool(false)
+	default:
+		Raise(ErrTypeMismatch, "Invalid '==' operands (%v and %v)", x, other)
+		return nil
+	}
+}
+
+func (x ForthXt) Ne(other Variant) Variant {
+	switch otherCast := other.(type) {
+	case ForthXt:
+		return ForthBool(x != otherCast)
+	case ForthNil:
+		return ForthBool(true)
+	default:
+		Raise(ErrTypeMismatch, "Invalid '!=' operands (%v and %v)", x, other)
+		return nil
+	}
+}
+
+func (x ForthXt) Lt(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '<' operands (%v and %v)", x, other)
+	return nil
+}
+
+func (x ForthXt) Gt(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '>' operands (%v and %v)", x, other)
+	return nil
+}
+
+func (x ForthXt) Le(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '<=' operands (%v and %v)", x, other)
+	return nil
+}
+
+func (x ForthXt) Ge(other Variant) Variant {
+	Raise(ErrTypeMismatch, "Invalid '>=' operands (%v and %v)", x, other)
+	return nil
+}
+
+func (x ForthXt) AsBool() bool {
+	return true
+}
+
+func (x ForthXt) TypeName() string {
+	return "xt"
+}
+
+func (x ForthXt) ToInt() (ForthInt, error) {
+	return 0, conversionError(x, "int")
+}
+
+func (x ForthXt) ToFloat() (ForthFloat, error) {
+	return 0, conversionError(x, "float")
+}
+
+func (x ForthXt) ToString() ForthString {
+	return ForthString(x)
+}